@@ -117,20 +117,6 @@ func (c *Config) loadAndValidate() error {
 	return nil
 }
 
-func (c *Config) blockStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewBlockStorageV1(c.OSClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
-	})
-}
-
-func (c *Config) blockStorageV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewBlockStorageV2(c.OSClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
-	})
-}
-
 func (c *Config) computeV2Client(region string) (*gophercloud.ServiceClient, error) {
 	return openstack.NewComputeV2(c.OSClient, gophercloud.EndpointOpts{
 		Region:       region,
@@ -0,0 +1,44 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"sort"
+	"strings"
+)
+
+func dataSourceMeSshKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMeSshKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMeSshKeysRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	names := []string{}
+	endpoint := "/me/sshKey"
+
+	err := config.OVHClient.Get(endpoint, &names)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	sort.Strings(names)
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(names, ","))))
+	d.Set("names", names)
+
+	log.Printf("[DEBUG] Read Account SSH Keys %v", names)
+	return nil
+}
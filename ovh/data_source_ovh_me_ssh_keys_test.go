@@ -0,0 +1,58 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"strconv"
+	"testing"
+)
+
+var testAccMeSshKeysDataSourceConfig = fmt.Sprintf(`
+resource "ovh_me_ssh_key" "key" {
+  key_name = "terraform_testacc_key"
+  key      = "%s"
+}
+
+data "ovh_me_ssh_keys" "keys" {
+  depends_on = ["ovh_me_ssh_key.key"]
+}
+`, testSSHPublicKey)
+
+func TestAccMeSshKeysDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMeSshKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccMeSshKeysDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeSshKeysContains("data.ovh_me_ssh_keys.keys", "terraform_testacc_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMeSshKeysContains(n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["names.#"])
+		if err != nil {
+			return fmt.Errorf("No names count is set: %s", err)
+		}
+
+		for i := 0; i < count; i++ {
+			if rs.Primary.Attributes[fmt.Sprintf("names.%d", i)] == name {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Account SSH Key %s not found in %s names", name, n)
+	}
+}
@@ -0,0 +1,123 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"net/url"
+)
+
+func dataSourcePublicCloudFlavors() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudFlavorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"flavors": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcpus": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ram": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"available": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type pcfResponse struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	Region    string `json:"region"`
+	Type      string `json:"type"`
+	OsType    string `json:"osType"`
+	Vcpus     int    `json:"vcpus"`
+	Ram       int    `json:"ram"`
+	Disk      int    `json:"disk"`
+	Available bool   `json:"available"`
+}
+
+func dataSourcePublicCloudFlavorsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/flavor", projectId)
+	if region != "" {
+		endpoint = fmt.Sprintf("%s?region=%s", endpoint, url.QueryEscape(region))
+	}
+
+	r := []*pcfResponse{}
+
+	err := config.OVHClient.Get(endpoint, &r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	flavors := make([]map[string]interface{}, 0)
+	for _, f := range r {
+		flavors = append(flavors, map[string]interface{}{
+			"id":        f.Id,
+			"name":      f.Name,
+			"region":    f.Region,
+			"type":      f.Type,
+			"os_type":   f.OsType,
+			"vcpus":     f.Vcpus,
+			"ram":       f.Ram,
+			"disk":      f.Disk,
+			"available": f.Available,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectId, region))
+	d.Set("flavors", flavors)
+
+	log.Printf("[DEBUG] Read %d flavors of project %s in region %q", len(flavors), projectId, region)
+	return nil
+}
@@ -0,0 +1,31 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudFlavorsDataSourceConfig = fmt.Sprintf(`
+data "ovh_publiccloud_flavors" "flavors" {
+  project_id = "%s"
+  region     = "GRA1"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudFlavorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudFlavorsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_publiccloud_flavors.flavors", "flavors.0.id"),
+					resource.TestCheckResourceAttr("data.ovh_publiccloud_flavors.flavors", "flavors.0.region", "GRA1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,169 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"log"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+func dataSourcePublicCloudImage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudImageRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_regex": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"os_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"linux", "windows", "bsd"}, false),
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"visibility": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"min_disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"creation_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type pcimResponse struct {
+	Id           string  `json:"id"`
+	Name         string  `json:"name"`
+	Region       string  `json:"region"`
+	Type         string  `json:"type"`
+	Status       string  `json:"status"`
+	Visibility   string  `json:"visibility"`
+	User         string  `json:"user"`
+	MinDisk      int     `json:"minDisk"`
+	MinRam       int     `json:"minRam"`
+	Size         float64 `json:"size"`
+	CreationDate string  `json:"creationDate"`
+}
+
+func dataSourcePublicCloudImageRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return fmt.Errorf("[ERROR] invalid name_regex %q: %s", d.Get("name_regex").(string), err)
+	}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/image?region=%s", projectId, url.QueryEscape(region))
+	if osType, ok := d.GetOk("os_type"); ok {
+		endpoint = fmt.Sprintf("%s&osType=%s", endpoint, url.QueryEscape(osType.(string)))
+	}
+
+	images := []*pcimResponse{}
+
+	err = config.OVHClient.Get(endpoint, &images)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	i := pcimLatest(images, nameRegex)
+	if i == nil {
+		return fmt.Errorf("[ERROR] no active image matching %q found in region %s", nameRegex, region)
+	}
+
+	d.SetId(i.Id)
+	d.Set("name", i.Name)
+	d.Set("type", i.Type)
+	d.Set("status", i.Status)
+	d.Set("visibility", i.Visibility)
+	d.Set("user", i.User)
+	d.Set("min_disk", i.MinDisk)
+	d.Set("min_ram", i.MinRam)
+	d.Set("size", i.Size)
+	d.Set("creation_date", i.CreationDate)
+
+	log.Printf("[DEBUG] Read latest image %s (%s) created on %s", i.Id, i.Name, i.CreationDate)
+	return nil
+}
+
+// pcimLatest returns the most recently created active image whose
+// name matches nameRegex, or nil if there is none. Images with an
+// unparseable creation date are only returned when no dated image
+// matches.
+func pcimLatest(images []*pcimResponse, nameRegex *regexp.Regexp) *pcimResponse {
+	var latest, undated *pcimResponse
+	var latestDate time.Time
+
+	for _, i := range images {
+		if i.Status != "active" || !nameRegex.MatchString(i.Name) {
+			continue
+		}
+
+		date, err := time.Parse(time.RFC3339, i.CreationDate)
+		if err != nil {
+			log.Printf("[WARN] couldn't parse creation date %q of image %s: %s", i.CreationDate, i.Id, err)
+			if undated == nil {
+				undated = i
+			}
+			continue
+		}
+
+		if latest == nil || date.After(latestDate) {
+			latest = i
+			latestDate = date
+		}
+	}
+
+	if latest == nil && undated != nil {
+		log.Printf("[WARN] no dated image matches %q, falling back to image %s", nameRegex, undated.Id)
+		return undated
+	}
+
+	return latest
+}
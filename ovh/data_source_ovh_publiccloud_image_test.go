@@ -0,0 +1,61 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"regexp"
+	"testing"
+)
+
+var testAccPublicCloudImageDataSourceConfig = fmt.Sprintf(`
+data "ovh_publiccloud_image" "ubuntu" {
+  project_id = "%s"
+  region     = "GRA1"
+  name_regex = "^Ubuntu"
+  os_type    = "linux"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudImageDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudImageDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_publiccloud_image.ubuntu", "id"),
+					resource.TestMatchResourceAttr("data.ovh_publiccloud_image.ubuntu", "name", regexp.MustCompile("^Ubuntu")),
+				),
+			},
+		},
+	})
+}
+
+func TestPublicCloudImageLatest(t *testing.T) {
+	images := []*pcimResponse{
+		&pcimResponse{Id: "1", Name: "Ubuntu 14.04", Status: "active", CreationDate: "2017-10-01T10:00:00Z"},
+		&pcimResponse{Id: "2", Name: "Ubuntu 16.04", Status: "active", CreationDate: "2017-10-03T10:00:00Z"},
+		&pcimResponse{Id: "3", Name: "Debian 9", Status: "active", CreationDate: "2017-10-04T10:00:00Z"},
+		&pcimResponse{Id: "4", Name: "Ubuntu 16.04", Status: "active", CreationDate: "invalid"},
+		&pcimResponse{Id: "5", Name: "Ubuntu 17.10", Status: "queued", CreationDate: "2017-10-05T10:00:00Z"},
+		&pcimResponse{Id: "6", Name: "Fedora 26", Status: "active", CreationDate: "invalid"},
+	}
+
+	if i := pcimLatest(images, regexp.MustCompile("^Ubuntu")); i == nil || i.Id != "2" {
+		t.Fatalf("expected image 2, got %v", i)
+	}
+
+	if i := pcimLatest(images, regexp.MustCompile("")); i == nil || i.Id != "3" {
+		t.Fatalf("expected image 3, got %v", i)
+	}
+
+	if i := pcimLatest(images, regexp.MustCompile("^Fedora")); i == nil || i.Id != "6" {
+		t.Fatalf("expected image 6, got %v", i)
+	}
+
+	if i := pcimLatest(images, regexp.MustCompile("^CentOS")); i != nil {
+		t.Fatalf("expected no image, got %v", i)
+	}
+}
@@ -0,0 +1,133 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+)
+
+func dataSourcePublicCloudPrivateNetwork() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudPrivateNetworkRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vlan_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"regions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"os_net_ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"regions_status": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"region": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePublicCloudPrivateNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	if networkId, ok := d.GetOk("id"); ok {
+		r := &pcpnResponse{}
+
+		log.Printf("[DEBUG] Will read public cloud private network for project: %s, id: %s", projectId, networkId)
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, networkId)
+
+		err := config.OVHClient.Get(endpoint, r)
+		if err != nil {
+			return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+		}
+
+		return readPcpn(config, d, r)
+	}
+
+	rs := []*pcpnResponse{}
+
+	log.Printf("[DEBUG] Will list public cloud private networks for project: %s", projectId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private", projectId)
+
+	err := config.OVHClient.Get(endpoint, &rs)
+	if err != nil {
+		return fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	// 0 is a valid vlan id, so vlan_id is a filter as soon as it is set
+	name, byName := d.GetOk("name")
+	vlanId, byVlan := d.GetOkExists("vlan_id")
+	if !byName && !byVlan {
+		return fmt.Errorf("[ERROR] One of id, name or vlan_id must be set to look up a private network")
+	}
+
+	matches := make([]*pcpnResponse, 0)
+	for i := range rs {
+		if byName && rs[i].Name != name.(string) {
+			continue
+		}
+		if byVlan && rs[i].Vlanid != vlanId.(int) {
+			continue
+		}
+		matches = append(matches, rs[i])
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("[ERROR] No private network found for project %s matching name: %q, vlan_id: %v", projectId, name, vlanId)
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("[ERROR] %d private networks found for project %s matching name: %q, vlan_id: %v", len(matches), projectId, name, vlanId)
+	}
+
+	log.Printf("[DEBUG] Found Public Cloud Private Network %s", matches[0])
+	return readPcpn(config, d, matches[0])
+}
@@ -0,0 +1,148 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+)
+
+func dataSourcePublicCloudPrivateNetworkSubnet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudPrivateNetworkSubnetRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dhcp": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"start": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"no_gateway": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"gateway_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_pools": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dhcp": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"end": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePublicCloudPrivateNetworkSubnetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+	region := d.Get("region").(string)
+	cidr := d.Get("cidr").(string)
+
+	if region == "" && cidr == "" {
+		return fmt.Errorf("[ERROR] One of region or cidr must be set to lookup a subnet of network %s", networkId)
+	}
+
+	rs := []*pcpnsResponse{}
+
+	log.Printf("[DEBUG] Will list public cloud private network subnets for project: %s, network: %s", projectId, networkId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet", projectId, networkId)
+
+	err := config.OVHClient.Get(endpoint, &rs)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	matches := make([]*pcpnsResponse, 0)
+	for i := range rs {
+		if cidr != "" && rs[i].Cidr != cidr {
+			continue
+		}
+		if region != "" && !pcpnsInRegion(rs[i], region) {
+			continue
+		}
+		matches = append(matches, rs[i])
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("[ERROR] No subnet found for project %s and network %s matching region: %q, cidr: %q", projectId, networkId, region, cidr)
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("[ERROR] %d subnets found for project %s and network %s matching region: %q, cidr: %q", len(matches), projectId, networkId, region, cidr)
+	}
+
+	d.SetId(matches[0].Id)
+
+	log.Printf("[DEBUG] Found Public Cloud Private Network Subnet %s", matches[0])
+	return readPcpns(d, rs)
+}
+
+func pcpnsInRegion(r *pcpnsResponse, region string) bool {
+	for i := range r.IPPools {
+		if r.IPPools[i].Region == region {
+			return true
+		}
+	}
+	return false
+}
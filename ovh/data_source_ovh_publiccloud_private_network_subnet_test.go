@@ -0,0 +1,58 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudPrivateNetworkSubnetDataSourceConfig = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id    = 0
+  name       = "terraform_testacc_private_net"
+  regions    = ["GRA1", "BHS1"]
+}
+
+resource "ovh_publiccloud_private_network_subnet" "subnet" {
+  project_id = "${ovh_publiccloud_private_network.network.project_id}"
+  network_id = "${ovh_publiccloud_private_network.network.id}"
+  region     = "GRA1"
+  start      = "192.168.168.100"
+  end        = "192.168.168.200"
+  network    = "192.168.168.0/24"
+  dhcp       = true
+  no_gateway = false
+}
+
+data "ovh_publiccloud_private_network_subnet" "subnet" {
+  project_id = "${ovh_publiccloud_private_network_subnet.subnet.project_id}"
+  network_id = "${ovh_publiccloud_private_network_subnet.subnet.network_id}"
+  region     = "${ovh_publiccloud_private_network_subnet.subnet.region}"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudPrivateNetworkSubnetDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkSubnetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_publiccloud_private_network_subnet.subnet", "cidr", "192.168.168.0/24"),
+					resource.TestCheckResourceAttr("data.ovh_publiccloud_private_network_subnet.subnet", "start", "192.168.168.100"),
+					resource.TestCheckResourceAttr("data.ovh_publiccloud_private_network_subnet.subnet", "end", "192.168.168.200"),
+					resource.TestCheckResourceAttrSet("data.ovh_publiccloud_private_network_subnet.subnet", "gateway_ip"),
+				),
+			},
+		},
+	})
+}
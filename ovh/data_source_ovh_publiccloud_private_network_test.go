@@ -0,0 +1,57 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudPrivateNetworkDataSourceConfig = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id    = 0
+  name       = "terraform_testacc_private_net"
+  regions    = ["GRA1", "BHS1"]
+}
+
+data "ovh_publiccloud_private_network" "by_name" {
+  project_id = "${ovh_publiccloud_private_network.network.project_id}"
+  name       = "${ovh_publiccloud_private_network.network.name}"
+}
+
+data "ovh_publiccloud_private_network" "by_id" {
+  project_id = "${ovh_publiccloud_private_network.network.project_id}"
+  id         = "${ovh_publiccloud_private_network.network.id}"
+}
+
+data "ovh_publiccloud_private_network" "by_vlan" {
+  project_id = "${ovh_publiccloud_private_network.network.project_id}"
+  vlan_id    = "${ovh_publiccloud_private_network.network.vlan_id}"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudPrivateNetworkDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_publiccloud_private_network.by_name", "vlan_id", "0"),
+					resource.TestCheckResourceAttr("data.ovh_publiccloud_private_network.by_name", "regions.#", "2"),
+					resource.TestCheckResourceAttrSet("data.ovh_publiccloud_private_network.by_name", "os_net_ids.GRA1"),
+					resource.TestCheckResourceAttr("data.ovh_publiccloud_private_network.by_id", "name", "terraform_testacc_private_net"),
+					resource.TestCheckResourceAttrPair("data.ovh_publiccloud_private_network.by_vlan", "id", "ovh_publiccloud_private_network.network", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"sort"
+)
+
+func dataSourcePublicCloudRegions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"regions": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"continent_code": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datacenter_location": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"services": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type pcrService struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type pcrResponse struct {
+	Name               string        `json:"name"`
+	Status             string        `json:"status"`
+	ContinentCode      string        `json:"continentCode"`
+	DatacenterLocation string        `json:"datacenterLocation"`
+	Services           []*pcrService `json:"services"`
+}
+
+func (p *pcrResponse) String() string {
+	return fmt.Sprintf("Name: %s, Status: %s, ContinentCode: %s, DatacenterLocation: %s", p.Name, p.Status, p.ContinentCode, p.DatacenterLocation)
+}
+
+func dataSourcePublicCloudRegionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	names := []string{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/region", projectId)
+
+	err := config.OVHClient.Get(endpoint, &names)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	sort.Strings(names)
+
+	regions := make([]map[string]interface{}, 0)
+	for _, name := range names {
+		r := &pcrResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/region/%s", projectId, name)
+
+		err := config.OVHClient.Get(endpoint, r)
+		if err != nil {
+			return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+		}
+		log.Printf("[DEBUG] Read Region %s", r)
+
+		services := make([]map[string]interface{}, 0)
+		for _, s := range r.Services {
+			services = append(services, map[string]interface{}{
+				"name":   s.Name,
+				"status": s.Status,
+			})
+		}
+
+		regions = append(regions, map[string]interface{}{
+			"name":                r.Name,
+			"status":              r.Status,
+			"continent_code":      r.ContinentCode,
+			"datacenter_location": r.DatacenterLocation,
+			"services":            services,
+		})
+	}
+
+	d.SetId(projectId)
+	d.Set("names", names)
+	d.Set("regions", regions)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudRegionsDataSourceConfig = fmt.Sprintf(`
+data "ovh_publiccloud_regions" "regions" {
+  project_id = "%s"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudRegionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudRegionsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_publiccloud_regions.regions", "names.0"),
+					resource.TestCheckResourceAttrSet("data.ovh_publiccloud_regions.regions", "regions.0.status"),
+					resource.TestCheckResourceAttrSet("data.ovh_publiccloud_regions.regions", "regions.0.services.#"),
+				),
+			},
+		},
+	})
+}
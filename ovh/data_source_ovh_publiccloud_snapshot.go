@@ -0,0 +1,218 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+func dataSourcePublicCloudSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudSnapshotRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "instance",
+				ValidateFunc: validation.StringInSlice([]string{"instance", "volume"}, false),
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// pcsSnapshot is the subset of the instance and volume
+// snapshots attributes exposed by the data source.
+type pcsSnapshot struct {
+	Id           string  `json:"id"`
+	Name         string  `json:"name"`
+	VolumeId     string  `json:"volumeId"`
+	Size         float64 `json:"size"`
+	Status       string  `json:"status"`
+	CreationDate string  `json:"creationDate"`
+}
+
+func dataSourcePublicCloudSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+	prefix := d.Get("name_prefix").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/snapshot?region=%s", projectId, url.QueryEscape(region))
+	if d.Get("type").(string) == "volume" {
+		endpoint = fmt.Sprintf("/cloud/project/%s/volume/snapshot?region=%s", projectId, url.QueryEscape(region))
+	}
+
+	snapshots := []*pcsSnapshot{}
+
+	err := config.OVHClient.Get(endpoint, &snapshots)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	s := pcsLatest(snapshots, prefix)
+	if s == nil {
+		return fmt.Errorf("[ERROR] no %s snapshot found in region %s with name prefix %q", d.Get("type"), region, prefix)
+	}
+
+	d.SetId(s.Id)
+	d.Set("name", s.Name)
+	d.Set("volume_id", s.VolumeId)
+	d.Set("size", s.Size)
+	d.Set("status", s.Status)
+	d.Set("creation_date", s.CreationDate)
+
+	log.Printf("[DEBUG] Read latest snapshot %s (%s) created on %s", s.Id, s.Name, s.CreationDate)
+
+	return nil
+}
+
+// pcsLatest returns the most recently created snapshot
+// whose name starts with prefix, or nil if there is none.
+func pcsLatest(snapshots []*pcsSnapshot, prefix string) *pcsSnapshot {
+	var latest *pcsSnapshot
+	var latestDate time.Time
+
+	for _, s := range snapshots {
+		if !strings.HasPrefix(s.Name, prefix) {
+			continue
+		}
+
+		date, err := time.Parse(time.RFC3339, s.CreationDate)
+		if err != nil {
+			log.Printf("[WARN] couldn't parse creation date %q of snapshot %s: %s", s.CreationDate, s.Id, err)
+			continue
+		}
+
+		if latest == nil || date.After(latestDate) {
+			latest = s
+			latestDate = date
+		}
+	}
+
+	return latest
+}
+
+type pcsDated struct {
+	snapshot *pcsSnapshot
+	date     time.Time
+}
+
+// pcsNewestFirst sorts snapshots from the most to the least recent.
+type pcsNewestFirst []pcsDated
+
+func (s pcsNewestFirst) Len() int           { return len(s) }
+func (s pcsNewestFirst) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s pcsNewestFirst) Less(i, j int) bool { return s[i].date.After(s[j].date) }
+
+// pcsExpired returns the snapshots named name beyond the keep most
+// recently created ones. Snapshots with an unparseable creation date
+// are never considered expired.
+func pcsExpired(snapshots []*pcsSnapshot, name string, keep int) []*pcsSnapshot {
+	matching := []pcsDated{}
+
+	for _, s := range snapshots {
+		if s.Name != name {
+			continue
+		}
+
+		date, err := time.Parse(time.RFC3339, s.CreationDate)
+		if err != nil {
+			log.Printf("[WARN] couldn't parse creation date %q of snapshot %s, keeping it: %s", s.CreationDate, s.Id, err)
+			continue
+		}
+
+		matching = append(matching, pcsDated{snapshot: s, date: date})
+	}
+
+	if len(matching) <= keep {
+		return nil
+	}
+
+	sort.Stable(pcsNewestFirst(matching))
+
+	expired := []*pcsSnapshot{}
+	for _, m := range matching[keep:] {
+		expired = append(expired, m.snapshot)
+	}
+
+	return expired
+}
+
+// pcsPrune deletes the snapshots of volumeId in region named name,
+// keeping the keep most recent ones.
+func pcsPrune(c *ovh.Client, projectId, region, volumeId, name string, keep int) error {
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot", projectId)
+
+	all := []*pcsSnapshot{}
+	listEndpoint := fmt.Sprintf("%s?region=%s", endpoint, url.QueryEscape(region))
+
+	err := c.Get(listEndpoint, &all)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", listEndpoint, err)
+	}
+
+	snapshots := []*pcsSnapshot{}
+	for _, s := range all {
+		if s.VolumeId == volumeId {
+			snapshots = append(snapshots, s)
+		}
+	}
+
+	for _, s := range pcsExpired(snapshots, name, keep) {
+		log.Printf("[DEBUG] Will prune volume snapshot %s (%s) created on %s", s.Id, s.Name, s.CreationDate)
+
+		deleteEndpoint := fmt.Sprintf("%s/%s", endpoint, s.Id)
+
+		err := c.Delete(deleteEndpoint, nil)
+		if err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] calling %s:\n\t %q", deleteEndpoint, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudSnapshotDataSourceConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_volume" "volume" {
+  project_id = "%s"
+  region     = "GRA1"
+  name       = "terraform_testacc_volume"
+  size       = 10
+}
+
+resource "ovh_publiccloud_volume_snapshot" "snapshot" {
+  project_id = "${ovh_publiccloud_volume.volume.project_id}"
+  volume_id  = "${ovh_publiccloud_volume.volume.id}"
+  name       = "terraform_testacc_snapshot"
+}
+
+data "ovh_publiccloud_snapshot" "latest" {
+  project_id  = "${ovh_publiccloud_volume_snapshot.snapshot.project_id}"
+  region      = "${ovh_publiccloud_volume_snapshot.snapshot.region}"
+  name_prefix = "terraform_testacc_"
+  type        = "volume"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudSnapshotDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudVolumePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudVolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudSnapshotDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ovh_publiccloud_snapshot.latest", "id", "ovh_publiccloud_volume_snapshot.snapshot", "id"),
+					resource.TestCheckResourceAttrPair("data.ovh_publiccloud_snapshot.latest", "volume_id", "ovh_publiccloud_volume.volume", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestPublicCloudSnapshotLatest(t *testing.T) {
+	snapshots := []*pcsSnapshot{
+		&pcsSnapshot{Id: "1", Name: "backup-1", CreationDate: "2017-10-01T10:00:00Z"},
+		&pcsSnapshot{Id: "2", Name: "backup-2", CreationDate: "2017-10-03T10:00:00Z"},
+		&pcsSnapshot{Id: "3", Name: "other-3", CreationDate: "2017-10-04T10:00:00Z"},
+		&pcsSnapshot{Id: "4", Name: "backup-4", CreationDate: "2017-10-02T10:00:00Z"},
+	}
+
+	if s := pcsLatest(snapshots, "backup-"); s == nil || s.Id != "2" {
+		t.Fatalf("expected snapshot 2, got %v", s)
+	}
+
+	if s := pcsLatest(snapshots, ""); s == nil || s.Id != "3" {
+		t.Fatalf("expected snapshot 3, got %v", s)
+	}
+
+	if s := pcsLatest(snapshots, "none-"); s != nil {
+		t.Fatalf("expected no snapshot, got %v", s)
+	}
+}
+
+func TestPublicCloudSnapshotExpired(t *testing.T) {
+	snapshots := []*pcsSnapshot{
+		&pcsSnapshot{Id: "1", Name: "backup", CreationDate: "2017-10-01T10:00:00Z"},
+		&pcsSnapshot{Id: "2", Name: "backup", CreationDate: "2017-10-03T10:00:00Z"},
+		&pcsSnapshot{Id: "3", Name: "backup-other", CreationDate: "2017-10-04T10:00:00Z"},
+		&pcsSnapshot{Id: "4", Name: "backup", CreationDate: "2017-10-02T10:00:00Z"},
+		&pcsSnapshot{Id: "5", Name: "backup", CreationDate: "unknown"},
+	}
+
+	expired := pcsExpired(snapshots, "backup", 1)
+	if len(expired) != 2 || expired[0].Id != "4" || expired[1].Id != "1" {
+		t.Fatalf("expected snapshots 4 and 1 to expire, got %v", expired)
+	}
+
+	if expired := pcsExpired(snapshots, "backup", 3); len(expired) != 0 {
+		t.Fatalf("expected no expired snapshot, got %v", expired)
+	}
+}
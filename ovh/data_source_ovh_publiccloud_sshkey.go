@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"log"
+	"net/url"
+)
+
+func dataSourcePublicCloudSshKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePublicCloudSshKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"public_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"regions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourcePublicCloudSshKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey", projectId)
+	if region, ok := d.GetOk("region"); ok {
+		endpoint = fmt.Sprintf("%s?region=%s", endpoint, url.QueryEscape(region.(string)))
+	}
+
+	keys := []*pcskResponse{}
+
+	err := config.OVHClient.Get(endpoint, &keys)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	var key *pcskResponse
+	for _, k := range keys {
+		if k.Name != name {
+			continue
+		}
+		if key != nil {
+			return fmt.Errorf("[ERROR] found several ssh keys named %q in project %s", name, projectId)
+		}
+		key = k
+	}
+
+	if key == nil {
+		return fmt.Errorf("[ERROR] no ssh key named %q found in project %s", name, projectId)
+	}
+
+	readPcsk(d, key)
+
+	log.Printf("[DEBUG] Read Public Cloud SSH Key %s", key)
+	return nil
+}
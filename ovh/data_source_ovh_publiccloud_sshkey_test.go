@@ -0,0 +1,38 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudSshKeyDataSourceConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_sshkey" "key" {
+  project_id = "%s"
+  name       = "terraform_testacc_key"
+  public_key = "%s"
+}
+
+data "ovh_publiccloud_sshkey" "key" {
+  project_id = "${ovh_publiccloud_sshkey.key.project_id}"
+  name       = "${ovh_publiccloud_sshkey.key.name}"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"), testSSHPublicKey)
+
+func TestAccPublicCloudSshKeyDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudSshKeyPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudSshKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudSshKeyDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ovh_publiccloud_sshkey.key", "id", "ovh_publiccloud_sshkey.key", "id"),
+					resource.TestCheckResourceAttr("data.ovh_publiccloud_sshkey.key", "public_key", testSSHPublicKey),
+				),
+			},
+		},
+	})
+}
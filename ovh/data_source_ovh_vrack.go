@@ -0,0 +1,130 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+)
+
+func dataSourceVRack() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVRackRead,
+
+		Schema: map[string]*schema.Schema{
+			"vrack_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloud_projects":              vrackServicesSchema(),
+			"dedicated_servers":           vrackServicesSchema(),
+			"dedicated_server_interfaces": vrackServicesSchema(),
+			"ips":                         vrackServicesSchema(),
+			"dedicated_clouds":            vrackServicesSchema(),
+
+			"allowed_cloud_projects":    vrackServicesSchema(),
+			"allowed_dedicated_servers": vrackServicesSchema(),
+			"allowed_ips":               vrackServicesSchema(),
+			"allowed_dedicated_clouds":  vrackServicesSchema(),
+		},
+	}
+}
+
+func vrackServicesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      schema.HashString,
+	}
+}
+
+type vrackResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (v *vrackResponse) String() string {
+	return fmt.Sprintf("VRack[Name: %s, Description: %s]", v.Name, v.Description)
+}
+
+// Only the services which are plain service names are exposed,
+// dedicatedServerInterface entries are objects.
+type vrackAllowedServicesResponse struct {
+	CloudProject    []string `json:"cloudProject"`
+	DedicatedServer []string `json:"dedicatedServer"`
+	Ip              []string `json:"ip"`
+	DedicatedCloud  []string `json:"dedicatedCloud"`
+}
+
+func dataSourceVRackRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+
+	r, err := vrackGet(config.OVHClient, vrackId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(vrackId)
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+
+	attached := map[string]string{
+		"cloud_projects":              "cloudProject",
+		"dedicated_servers":           "dedicatedServer",
+		"dedicated_server_interfaces": "dedicatedServerInterface",
+		"ips":                         "ip",
+		"dedicated_clouds":            "dedicatedCloud",
+	}
+
+	for k, path := range attached {
+		services := []string{}
+		endpoint := fmt.Sprintf("/vrack/%s/%s", vrackId, path)
+
+		err := config.OVHClient.Get(endpoint, &services)
+		if err != nil {
+			return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+		}
+		d.Set(k, services)
+	}
+
+	allowed := &vrackAllowedServicesResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/allowedServices", vrackId)
+
+	err = config.OVHClient.Get(endpoint, allowed)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("allowed_cloud_projects", allowed.CloudProject)
+	d.Set("allowed_dedicated_servers", allowed.DedicatedServer)
+	d.Set("allowed_ips", allowed.Ip)
+	d.Set("allowed_dedicated_clouds", allowed.DedicatedCloud)
+
+	log.Printf("[DEBUG] Read VRack %s: %s", vrackId, r)
+
+	return nil
+}
+
+func vrackGet(c *ovh.Client, vrackId string) (*vrackResponse, error) {
+	r := &vrackResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s", vrackId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccVRackDataSourceConfig = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+data "ovh_vrack" "vrack" {
+  vrack_id = "${ovh_vrack_publiccloud_attachment.attach.vrack_id}"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccVRackDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckVRackPublicCloudAttachmentPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVRackPublicCloudAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVRackDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_vrack.vrack", "vrack_id", os.Getenv("OVH_VRACK")),
+					resource.TestCheckResourceAttrSet("data.ovh_vrack.vrack", "name"),
+					resource.TestCheckResourceAttr("data.ovh_vrack.vrack", "cloud_projects.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,20 @@
+package ovh
+
+import (
+	"encoding/json"
+)
+
+// normalizeJSON returns a compact representation of a JSON document with
+// sorted keys, so that equivalent documents are compared equal.
+func normalizeJSON(value string) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,25 @@
+package ovh
+
+import (
+	"testing"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	v, err := normalizeJSON(`{
+  "Statement": [
+    {"Effect": "Allow", "Action": ["s3:GetObject"]}
+  ]
+}`)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := `{"Statement":[{"Action":["s3:GetObject"],"Effect":"Allow"}]}`
+	if v != expected {
+		t.Fatalf("expected %s, got %s", expected, v)
+	}
+
+	if _, err := normalizeJSON(`{"Statement": [}`); err == nil {
+		t.Fatalf("invalid JSON should be an error")
+	}
+}
@@ -56,12 +56,40 @@ func Provider() terraform.ResourceProvider {
 			},
 		},
 
+		DataSourcesMap: map[string]*schema.Resource{
+			"ovh_publiccloud_private_network":        dataSourcePublicCloudPrivateNetwork(),
+			"ovh_publiccloud_private_network_subnet": dataSourcePublicCloudPrivateNetworkSubnet(),
+			"ovh_vrack":                              dataSourceVRack(),
+			"ovh_publiccloud_snapshot":               dataSourcePublicCloudSnapshot(),
+			"ovh_publiccloud_sshkey":                 dataSourcePublicCloudSshKey(),
+			"ovh_me_ssh_keys":                        dataSourceMeSshKeys(),
+			"ovh_publiccloud_flavors":                dataSourcePublicCloudFlavors(),
+			"ovh_publiccloud_image":                  dataSourcePublicCloudImage(),
+			"ovh_publiccloud_regions":                dataSourcePublicCloudRegions(),
+		},
+
 		ResourcesMap: map[string]*schema.Resource{
-			"ovh_vrack_publiccloud_attachment":       resourceVRackPublicCloudAttachment(),
-			"ovh_publiccloud_private_network":        resourcePublicCloudPrivateNetwork(),
-			"ovh_publiccloud_private_network_subnet": resourcePublicCloudPrivateNetworkSubnet(),
-			"ovh_publiccloud_user":                   resourcePublicCloudUser(),
-			"ovh_domain_record":			  resourceDomainRecord(),
+			"ovh_vrack_publiccloud_attachment":                resourceVRackPublicCloudAttachment(),
+			"ovh_publiccloud_private_network":                 resourcePublicCloudPrivateNetwork(),
+			"ovh_publiccloud_private_network_subnet":          resourcePublicCloudPrivateNetworkSubnet(),
+			"ovh_publiccloud_private_network_subnets":         resourcePublicCloudPrivateNetworkSubnets(),
+			"ovh_publiccloud_user":                            resourcePublicCloudUser(),
+			"ovh_publiccloud_user_s3_credential":              resourcePublicCloudUserS3Credential(),
+			"ovh_domain_record":                               resourceDomainRecord(),
+			"ovh_publiccloud_user_s3_policy":                  resourcePublicCloudUserS3Policy(),
+			"ovh_vrack_dedicated_server_attachment":           resourceVRackDedicatedServerAttachment(),
+			"ovh_vrack_dedicated_server_interface_attachment": resourceVRackDedicatedServerInterfaceAttachment(),
+			"ovh_vrack_ip":                                    resourceVRackIP(),
+			"ovh_vrack":                                       resourceVRack(),
+			"ovh_publiccloud_instance":                        resourcePublicCloudInstance(),
+			"ovh_publiccloud_instance_monthly_billing":        resourcePublicCloudInstanceMonthlyBilling(),
+			"ovh_publiccloud_volume":                          resourcePublicCloudVolume(),
+			"ovh_publiccloud_volume_attachment":               resourcePublicCloudVolumeAttachment(),
+			"ovh_publiccloud_volume_snapshot":                 resourcePublicCloudVolumeSnapshot(),
+			"ovh_publiccloud_instance_snapshot":               resourcePublicCloudInstanceSnapshot(),
+			"ovh_publiccloud_workflow_backup":                 resourcePublicCloudWorkflowBackup(),
+			"ovh_publiccloud_sshkey":                          resourcePublicCloudSshKey(),
+			"ovh_me_ssh_key":                                  resourceMeSshKey(),
 		},
 
 		ConfigureFunc: configureProvider,
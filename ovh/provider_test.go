@@ -79,21 +79,24 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
-func testAccCheckVRackExists(t *testing.T) {
-	type vrackResponse struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
+func testAccCheckOpenstackPreCheck(t *testing.T) {
+	v := os.Getenv("OS_AUTH_URL")
+	if v == "" {
+		t.Fatal("OS_AUTH_URL must be set for acceptance tests using openstack")
 	}
 
-	r := vrackResponse{}
-
-	endpoint := fmt.Sprintf("/vrack/%s", os.Getenv("OVH_VRACK"))
+	v = os.Getenv("OS_TENANT_NAME")
+	if v == "" {
+		t.Fatal("OS_TENANT_NAME must be set for acceptance tests using openstack")
+	}
+}
 
-	err := testAccOVHClient.Get(endpoint, &r)
+func testAccCheckVRackExists(t *testing.T) {
+	r, err := vrackGet(testAccOVHClient, os.Getenv("OVH_VRACK"))
 	if err != nil {
 		t.Fatalf("Error: %q\n", err)
 	}
-	t.Logf("Read VRack %s -> name:'%s', desc:'%s' ", endpoint, r.Name, r.Description)
+	t.Logf("Read VRack %s -> name:'%s', desc:'%s' ", os.Getenv("OVH_VRACK"), r.Name, r.Description)
 
 }
 
@@ -0,0 +1,187 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"net/url"
+	"strings"
+)
+
+func resourceMeSshKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMeSshKeyCreate,
+		Read:   resourceMeSshKeyRead,
+		Update: resourceMeSshKeyUpdate,
+		Delete: resourceMeSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("key_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSSHPublicKey,
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSpace(v.(string))
+				},
+			},
+			"default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// Params
+type meSshKeyCreateParams struct {
+	KeyName string `json:"keyName"`
+	Key     string `json:"key"`
+}
+
+// Params
+type meSshKeyUpdateParams struct {
+	Default bool `json:"default"`
+}
+
+type meSshKeyResponse struct {
+	KeyName string `json:"keyName"`
+	Key     string `json:"key"`
+	Default bool   `json:"default"`
+}
+
+func (p *meSshKeyResponse) String() string {
+	return fmt.Sprintf("KeyName: %s, Default: %t", p.KeyName, p.Default)
+}
+
+func resourceMeSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &meSshKeyCreateParams{
+		KeyName: d.Get("key_name").(string),
+		Key:     strings.TrimSpace(d.Get("key").(string)),
+	}
+
+	log.Printf("[DEBUG] Will create account ssh key: %s", params.KeyName)
+
+	endpoint := "/me/sshKey"
+
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with key name %s:\n\t %q", endpoint, params.KeyName, err)
+	}
+
+	//set id
+	d.SetId(params.KeyName)
+
+	// a new key is never the default one
+	if d.Get("default").(bool) {
+		if err := meSshKeyUpdateDefault(config.OVHClient, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	return resourceMeSshKeyRead(d, meta)
+}
+
+func resourceMeSshKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	r := &meSshKeyResponse{}
+	endpoint := fmt.Sprintf("/me/sshKey/%s", url.PathEscape(d.Id()))
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Account SSH Key %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("key_name", r.KeyName)
+	d.Set("key", strings.TrimSpace(r.Key))
+	d.Set("default", r.Default)
+
+	log.Printf("[DEBUG] Read Account SSH Key %s", r)
+	return nil
+}
+
+func resourceMeSshKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("default") {
+		if err := meSshKeyUpdateDefault(config.OVHClient, d.Id(), d.Get("default").(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceMeSshKeyRead(d, meta)
+}
+
+func resourceMeSshKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Will delete account ssh key: %s", d.Id())
+
+	endpoint := fmt.Sprintf("/me/sshKey/%s", url.PathEscape(d.Id()))
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Deleted Account SSH Key %s", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
+
+// meSshKeyUpdateDefault sets or unsets the default flag of a key.
+// Setting it unsets the flag of the previous default key.
+func meSshKeyUpdateDefault(c *ovh.Client, keyName string, isDefault bool) error {
+	params := &meSshKeyUpdateParams{Default: isDefault}
+	endpoint := fmt.Sprintf("/me/sshKey/%s", url.PathEscape(keyName))
+
+	log.Printf("[DEBUG] Will set account ssh key %s default flag to %t", keyName, isDefault)
+
+	err := c.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return nil
+}
+
+func meSshKeyExists(keyName string, c *ovh.Client) error {
+	r := &meSshKeyResponse{}
+
+	endpoint := fmt.Sprintf("/me/sshKey/%s", url.PathEscape(keyName))
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read account ssh key: %s", r)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"testing"
+)
+
+var testAccMeSshKeyConfig = `
+resource "ovh_me_ssh_key" "key" {
+  key_name = "terraform_testacc_key"
+  key      = "%s"
+  default  = %t
+}
+`
+
+func TestAccMeSshKey_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMeSshKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccMeSshKeyConfig, testSSHPublicKey, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMeSshKeyExists("ovh_me_ssh_key.key", t),
+					resource.TestCheckResourceAttr("ovh_me_ssh_key.key", "key", testSSHPublicKey),
+					resource.TestCheckResourceAttr("ovh_me_ssh_key.key", "default", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccMeSshKeyConfig, testSSHPublicKey, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_me_ssh_key.key", "default", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_me_ssh_key.key",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMeSshKeyExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return meSshKeyExists(rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckMeSshKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_me_ssh_key" {
+			continue
+		}
+
+		err := meSshKeyExists(rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Account SSH Key %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,631 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var pciID = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
+func resourcePublicCloudInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudInstanceCreate,
+		Read:   resourcePublicCloudInstanceRead,
+		Update: resourcePublicCloudInstanceUpdate,
+		Delete: resourcePublicCloudInstanceDelete,
+
+		CustomizeDiff: resourcePublicCloudInstanceCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pciID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor instance id from id %q, expected projectId/instanceId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudInstanceRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"flavor_name"},
+			},
+			"flavor_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"flavor_id"},
+			},
+			"image_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"image_name"},
+			},
+			"image_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"image_id"},
+			},
+			"ssh_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"user_data": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"networks": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// either an ovh_publiccloud_private_network id
+						// or an openstack network id, such as Ext-Net's one
+						"network_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ip": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateIP,
+						},
+					},
+				},
+			},
+			"monthly_billing": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"network_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gateway_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Params
+type pciNetworkParams struct {
+	NetworkId string `json:"networkId"`
+	Ip        string `json:"ip,omitempty"`
+}
+
+// Params
+type pciCreateParams struct {
+	ProjectId      string              `json:"serviceName"`
+	Name           string              `json:"name"`
+	Region         string              `json:"region"`
+	FlavorId       string              `json:"flavorId"`
+	ImageId        string              `json:"imageId"`
+	SshKeyId       string              `json:"sshKeyId,omitempty"`
+	UserData       string              `json:"userData,omitempty"`
+	MonthlyBilling bool                `json:"monthlyBilling"`
+	Networks       []*pciNetworkParams `json:"networks,omitempty"`
+}
+
+func (p *pciCreateParams) String() string {
+	return fmt.Sprintf("projectId: %s, name: %s, region: %s, flavorId: %s, imageId: %s, sshKeyId: %s, monthlyBilling: %t", p.ProjectId, p.Name, p.Region, p.FlavorId, p.ImageId, p.SshKeyId, p.MonthlyBilling)
+}
+
+// Params
+type pciUpdateParams struct {
+	InstanceName string `json:"instanceName"`
+}
+
+type pciIPAddress struct {
+	Ip        string `json:"ip"`
+	Type      string `json:"type"`
+	Version   int    `json:"version"`
+	NetworkId string `json:"networkId"`
+	GatewayIp string `json:"gatewayIp"`
+}
+
+type pciMonthlyBilling struct {
+	Since  string `json:"since"`
+	Status string `json:"status"`
+}
+
+type pciFlavor struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Region string `json:"region"`
+}
+
+type pciImage struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Region string `json:"region"`
+}
+
+type pciSshKey struct {
+	Id string `json:"id"`
+}
+
+type pciResponse struct {
+	Id             string             `json:"id"`
+	Name           string             `json:"name"`
+	Status         string             `json:"status"`
+	Region         string             `json:"region"`
+	Created        string             `json:"created"`
+	Flavor         *pciFlavor         `json:"flavor"`
+	Image          *pciImage          `json:"image"`
+	SshKey         *pciSshKey         `json:"sshKey"`
+	MonthlyBilling *pciMonthlyBilling `json:"monthlyBilling"`
+	IpAddresses    []*pciIPAddress    `json:"ipAddresses"`
+}
+
+func (p *pciResponse) String() string {
+	return fmt.Sprintf("Id: %s, Name: %s, Status: %s, Region: %s", p.Id, p.Name, p.Status, p.Region)
+}
+
+// Monthly billing can't be deactivated once switched on, reject it at plan time.
+func resourcePublicCloudInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("monthly_billing") {
+		return nil
+	}
+
+	o, n := d.GetChange("monthly_billing")
+	if o.(bool) && !n.(bool) {
+		return fmt.Errorf("[ERROR] monthly billing of instance %s can't be switched back to hourly billing", d.Id())
+	}
+
+	return nil
+}
+
+func resourcePublicCloudInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	flavorId, err := pciFlavorId(config.OVHClient, projectId, region, d)
+	if err != nil {
+		return err
+	}
+
+	imageId, err := pciImageId(config.OVHClient, projectId, region, d)
+	if err != nil {
+		return err
+	}
+
+	networks, err := pciNetworksParams(config, projectId, region, d)
+	if err != nil {
+		return err
+	}
+
+	params := &pciCreateParams{
+		ProjectId:      projectId,
+		Name:           d.Get("name").(string),
+		Region:         region,
+		FlavorId:       flavorId,
+		ImageId:        imageId,
+		SshKeyId:       d.Get("ssh_key_id").(string),
+		UserData:       d.Get("user_data").(string),
+		MonthlyBilling: d.Get("monthly_billing").(bool),
+		Networks:       networks,
+	}
+
+	r := &pciResponse{}
+
+	log.Printf("[DEBUG] Will create public cloud instance: %s", params)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance", projectId)
+
+	err = config.OVHClient.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Instance %s:", r)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"BUILD", "BUILDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    pciRefreshFunc(config.OVHClient, projectId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] waiting for instance (%s): %s", params, err)
+	}
+	log.Printf("[DEBUG] Created Instance %s", r)
+
+	//set id
+	d.SetId(r.Id)
+
+	return resourcePublicCloudInstanceRead(d, meta)
+}
+
+func resourcePublicCloudInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	r := &pciResponse{}
+
+	log.Printf("[DEBUG] Will read public cloud instance for project: %s, id: %s", projectId, d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Instance %s not found in project %s, removing from state", d.Id(), projectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	readPci(d, r)
+
+	log.Printf("[DEBUG] Read Public Cloud Instance %s", r)
+	return nil
+}
+
+func readPci(d *schema.ResourceData, r *pciResponse) {
+	d.Set("name", r.Name)
+	d.Set("region", r.Region)
+	d.Set("status", r.Status)
+	d.Set("created", r.Created)
+
+	if r.Flavor != nil {
+		d.Set("flavor_id", r.Flavor.Id)
+		d.Set("flavor_name", r.Flavor.Name)
+	}
+
+	if r.Image != nil {
+		d.Set("image_id", r.Image.Id)
+		d.Set("image_name", r.Image.Name)
+	}
+
+	if r.SshKey != nil {
+		d.Set("ssh_key_id", r.SshKey.Id)
+	}
+
+	d.Set("monthly_billing", r.MonthlyBilling != nil)
+
+	ips := make([]map[string]interface{}, 0)
+	for _, ip := range r.IpAddresses {
+		ips = append(ips, map[string]interface{}{
+			"ip":         ip.Ip,
+			"type":       ip.Type,
+			"version":    ip.Version,
+			"network_id": ip.NetworkId,
+			"gateway_ip": ip.GatewayIp,
+		})
+	}
+	d.Set("ip_addresses", ips)
+}
+
+func resourcePublicCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	if d.HasChange("name") {
+		params := &pciUpdateParams{
+			InstanceName: d.Get("name").(string),
+		}
+
+		log.Printf("[DEBUG] Will rename public cloud instance %s: %s", d.Id(), params.InstanceName)
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, d.Id())
+
+		err := config.OVHClient.Put(endpoint, params, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	// switching back to hourly billing is rejected by CustomizeDiff
+	if d.HasChange("monthly_billing") && d.Get("monthly_billing").(bool) {
+		if err := pciActivateMonthlyBilling(config.OVHClient, projectId, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourcePublicCloudInstanceRead(d, meta)
+}
+
+func resourcePublicCloudInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	id := d.Id()
+
+	log.Printf("[DEBUG] Will delete public cloud instance for project: %s, id: %s", projectId, id)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, id)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "SHUTOFF", "ERROR", "DELETING", "DELETED"},
+		Target:     []string{"deleted"},
+		Refresh:    pciDeleteRefreshFunc(config.OVHClient, projectId, id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] deleting instance %s from project %s: %s", id, projectId, err)
+	}
+	log.Printf("[DEBUG] Deleted Public Cloud Instance %s from project %s", id, projectId)
+
+	d.SetId("")
+
+	return nil
+}
+
+// pciFlavorId returns the flavor id set in the config,
+// or looks it up by name in the instance's region.
+func pciFlavorId(c *ovh.Client, projectId, region string, d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("flavor_id"); ok {
+		return v.(string), nil
+	}
+
+	name, ok := d.GetOk("flavor_name")
+	if !ok {
+		return "", fmt.Errorf("[ERROR] one of flavor_id or flavor_name must be set")
+	}
+
+	flavors := []*pciFlavor{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/flavor?region=%s", projectId, url.QueryEscape(region))
+
+	err := c.Get(endpoint, &flavors)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	for _, f := range flavors {
+		if f.Name == name.(string) {
+			return f.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("[ERROR] no flavor named %q found in region %s", name, region)
+}
+
+// pciImageId returns the image id set in the config,
+// or looks up the active image with this name in the instance's region.
+func pciImageId(c *ovh.Client, projectId, region string, d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("image_id"); ok {
+		return v.(string), nil
+	}
+
+	name, ok := d.GetOk("image_name")
+	if !ok {
+		return "", fmt.Errorf("[ERROR] one of image_id or image_name must be set")
+	}
+
+	images := []*pcimResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/image?region=%s", projectId, url.QueryEscape(region))
+
+	err := c.Get(endpoint, &images)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	for _, i := range images {
+		if i.Status == "active" && i.Name == name.(string) {
+			return i.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("[ERROR] no active image named %q found in region %s", name, region)
+}
+
+// pciNetworksParams builds the networks params of an instance. Private
+// network ids (pn-xxx) are translated into their openstack id in the
+// instance's region; other ids are passed as is.
+func pciNetworksParams(config *Config, projectId, region string, d *schema.ResourceData) ([]*pciNetworkParams, error) {
+	networks := make([]*pciNetworkParams, 0)
+
+	for _, v := range d.Get("networks").([]interface{}) {
+		n := v.(map[string]interface{})
+		params := &pciNetworkParams{
+			NetworkId: n["network_id"].(string),
+			Ip:        n["ip"].(string),
+		}
+
+		if strings.HasPrefix(params.NetworkId, "pn-") {
+			osId, err := pcpnsOpenstackNetworkId(config, projectId, params.NetworkId, region)
+			if err != nil {
+				return nil, err
+			}
+			params.NetworkId = osId
+		}
+
+		networks = append(networks, params)
+	}
+
+	return networks, nil
+}
+
+// pciActivateMonthlyBilling switches an instance to monthly billing and
+// waits for the switch to be effective. This can't be undone.
+func pciActivateMonthlyBilling(c *ovh.Client, projectId, instanceId string) error {
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s/activeMonthlyBilling", projectId, instanceId)
+
+	log.Printf("[DEBUG] Will activate monthly billing of instance %s in project %s", instanceId, projectId)
+
+	err := c.Post(endpoint, nil, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"", "activationPending"},
+		Target:     []string{"ok"},
+		Refresh:    pciMonthlyBillingRefreshFunc(c, projectId, instanceId),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] waiting for monthly billing of instance %s: %s", instanceId, err)
+	}
+	log.Printf("[DEBUG] Activated monthly billing of instance %s in project %s", instanceId, projectId)
+
+	return nil
+}
+
+func pciExists(projectId, id string, c *ovh.Client) error {
+	r := &pciResponse{}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read instance: %s", r)
+
+	return nil
+}
+
+// InstanceRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud instance.
+func pciRefreshFunc(c *ovh.Client, projectId, pciId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := &pciResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, pciId)
+		err := c.Get(endpoint, r)
+		if err != nil {
+			return r, "", err
+		}
+
+		log.Printf("[DEBUG] Pending Instance: %s", r)
+		return r, r.Status, nil
+	}
+}
+
+// InstanceDeleteRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud instance being deleted.
+func pciDeleteRefreshFunc(c *ovh.Client, projectId, pciId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := &pciResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, pciId)
+		err := c.Get(endpoint, r)
+		if err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				log.Printf("[DEBUG] instance id %s on project %s deleted", pciId, projectId)
+				return r, "deleted", nil
+			}
+			return r, "", err
+		}
+
+		log.Printf("[DEBUG] Pending Instance: %s", r)
+		return r, r.Status, nil
+	}
+}
+
+// MonthlyBillingRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// the monthly billing activation of an OVH public cloud instance.
+func pciMonthlyBillingRefreshFunc(c *ovh.Client, projectId, pciId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := &pciResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, pciId)
+		err := c.Get(endpoint, r)
+		if err != nil {
+			return r, "", err
+		}
+
+		if r.MonthlyBilling == nil {
+			return r, "", nil
+		}
+
+		log.Printf("[DEBUG] Pending monthly billing of instance %s: %s", pciId, r.MonthlyBilling.Status)
+		return r, r.MonthlyBilling.Status, nil
+	}
+}
@@ -0,0 +1,128 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+)
+
+var pcimbID = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
+func resourcePublicCloudInstanceMonthlyBilling() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudInstanceMonthlyBillingCreate,
+		Read:   resourcePublicCloudInstanceMonthlyBillingRead,
+		Delete: resourcePublicCloudInstanceMonthlyBillingDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcimbID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor instance id from id %q, expected projectId/instanceId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.Set("instance_id", params[2])
+				d.SetId(params[2])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"since": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Monthly billing is activated on Create and can't be deactivated:
+// Delete only releases the resource from the state.
+func resourcePublicCloudInstanceMonthlyBillingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	r := &pciResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, instanceId)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	if r.MonthlyBilling != nil && r.MonthlyBilling.Status == "ok" {
+		log.Printf("[DEBUG] Monthly billing of instance %s is already active since %s", instanceId, r.MonthlyBilling.Since)
+	} else if err := pciActivateMonthlyBilling(config.OVHClient, projectId, instanceId); err != nil {
+		return err
+	}
+
+	//set id
+	d.SetId(instanceId)
+
+	return resourcePublicCloudInstanceMonthlyBillingRead(d, meta)
+}
+
+func resourcePublicCloudInstanceMonthlyBillingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	instanceId := d.Id()
+
+	r := &pciResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, instanceId)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Instance %s not found in project %s, removing monthly billing from state", instanceId, projectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	if r.MonthlyBilling == nil {
+		log.Printf("[WARN] Instance %s in project %s is not billed monthly, removing from state", instanceId, projectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceId)
+	d.Set("since", r.MonthlyBilling.Since)
+	d.Set("status", r.MonthlyBilling.Status)
+
+	log.Printf("[DEBUG] Read monthly billing of instance %s: %s", instanceId, r.MonthlyBilling.Status)
+
+	return nil
+}
+
+// The OVH API has no way to switch an instance back to hourly billing:
+// destroying the resource only removes it from the state, the instance
+// stays billed monthly until it is deleted. This is documented in the
+// README.
+func resourcePublicCloudInstanceMonthlyBillingDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Monthly billing can't be deactivated: instance %s will stay billed monthly until it is deleted", d.Id())
+
+	d.SetId("")
+	return nil
+}
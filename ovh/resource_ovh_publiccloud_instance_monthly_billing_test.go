@@ -0,0 +1,40 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudInstanceMonthlyBillingConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_instance" "instance" {
+  project_id  = "%s"
+  name        = "terraform_testacc_instance"
+  region      = "GRA1"
+  flavor_name = "s1-2"
+  image_name  = "Ubuntu 16.04"
+}
+
+resource "ovh_publiccloud_instance_monthly_billing" "billing" {
+  project_id  = "${ovh_publiccloud_instance.instance.project_id}"
+  instance_id = "${ovh_publiccloud_instance.instance.id}"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudInstanceMonthlyBilling_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudInstanceMonthlyBillingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_publiccloud_instance_monthly_billing.billing", "status", "ok"),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_instance_monthly_billing.billing", "since"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,296 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+var pcisID = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
+func resourcePublicCloudInstanceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudInstanceSnapshotCreate,
+		Read:   resourcePublicCloudInstanceSnapshotRead,
+		Delete: resourcePublicCloudInstanceSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcisID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor snapshot id from id %q, expected projectId/snapshotId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudInstanceSnapshotRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			// the API doesn't return the snapshotted instance, so it
+			// is unknown after an import and mustn't force a new snapshot
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"min_disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Params
+type pcisCreateParams struct {
+	SnapshotName string `json:"snapshotName"`
+}
+
+type pcisResponse struct {
+	Id           string  `json:"id"`
+	Name         string  `json:"name"`
+	Region       string  `json:"region"`
+	Size         float64 `json:"size"`
+	MinDisk      int     `json:"minDisk"`
+	Status       string  `json:"status"`
+	CreationDate string  `json:"creationDate"`
+}
+
+func (p *pcisResponse) String() string {
+	return fmt.Sprintf("Id: %s, Name: %s, Region: %s, Status: %s, CreationDate: %s", p.Id, p.Name, p.Region, p.Status, p.CreationDate)
+}
+
+// The snapshot endpoint doesn't return the created snapshot: it is looked
+// up afterwards by name amongst the snapshots which didn't exist before.
+func resourcePublicCloudInstanceSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	params := &pcisCreateParams{SnapshotName: d.Get("name").(string)}
+
+	instance := &pciResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/instance/%s", projectId, instanceId)
+
+	err := config.OVHClient.Get(endpoint, instance)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	existing, err := pcisList(config.OVHClient, projectId, instance.Region)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool)
+	for _, s := range existing {
+		known[s.Id] = true
+	}
+
+	log.Printf("[DEBUG] Will snapshot public cloud instance %s: %s", instanceId, params.SnapshotName)
+
+	endpoint = fmt.Sprintf("/cloud/project/%s/instance/%s/snapshot", projectId, instanceId)
+
+	err = config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"queued", "saving"},
+		Target:     []string{"active"},
+		Refresh:    pcisCreateRefreshFunc(config.OVHClient, projectId, instance.Region, params.SnapshotName, known),
+		Timeout:    30 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	s, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] waiting for snapshot %s of instance %s: %s", params.SnapshotName, instanceId, err)
+	}
+	r := s.(*pcisResponse)
+	log.Printf("[DEBUG] Created Instance Snapshot %s", r)
+
+	//set id
+	d.SetId(r.Id)
+
+	return resourcePublicCloudInstanceSnapshotRead(d, meta)
+}
+
+func resourcePublicCloudInstanceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	r := &pcisResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/snapshot/%s", projectId, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Instance Snapshot %s not found in project %s, removing from state", d.Id(), projectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("name", r.Name)
+	d.Set("region", r.Region)
+	d.Set("size", r.Size)
+	d.Set("min_disk", r.MinDisk)
+	d.Set("status", r.Status)
+	d.Set("creation_date", r.CreationDate)
+
+	log.Printf("[DEBUG] Read Public Cloud Instance Snapshot %s", r)
+	return nil
+}
+
+func resourcePublicCloudInstanceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	id := d.Id()
+
+	log.Printf("[DEBUG] Will delete public cloud instance snapshot for project: %s, id: %s", projectId, id)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/snapshot/%s", projectId, id)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "deleting", "pending_delete"},
+		Target:     []string{"deleted"},
+		Refresh:    pcisDeleteRefreshFunc(config.OVHClient, projectId, id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] deleting instance snapshot %s from project %s: %s", id, projectId, err)
+	}
+	log.Printf("[DEBUG] Deleted Public Cloud Instance Snapshot %s from project %s", id, projectId)
+
+	d.SetId("")
+
+	return nil
+}
+
+func pcisList(c *ovh.Client, projectId, region string) ([]*pcisResponse, error) {
+	r := []*pcisResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/snapshot?region=%s", projectId, url.QueryEscape(region))
+
+	err := c.Get(endpoint, &r)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	return r, nil
+}
+
+func pcisExists(projectId, id string, c *ovh.Client) error {
+	r := &pcisResponse{}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/snapshot/%s", projectId, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read instance snapshot: %s", r)
+
+	return nil
+}
+
+// InstanceSnapshotCreateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud instance snapshot being created.
+func pcisCreateRefreshFunc(c *ovh.Client, projectId, region, name string, known map[string]bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshots, err := pcisList(c, projectId, region)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, s := range snapshots {
+			if s.Name == name && !known[s.Id] {
+				log.Printf("[DEBUG] Pending Instance Snapshot: %s", s)
+				return s, s.Status, nil
+			}
+		}
+
+		log.Printf("[DEBUG] Instance Snapshot %s not listed yet in region %s", name, region)
+		return nil, "", nil
+	}
+}
+
+// InstanceSnapshotDeleteRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud instance snapshot being deleted.
+func pcisDeleteRefreshFunc(c *ovh.Client, projectId, pcisId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := &pcisResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/snapshot/%s", projectId, pcisId)
+		err := c.Get(endpoint, r)
+		if err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				log.Printf("[DEBUG] instance snapshot id %s on project %s deleted", pcisId, projectId)
+				return r, "deleted", nil
+			}
+			return r, "", err
+		}
+
+		log.Printf("[DEBUG] Pending Instance Snapshot: %s", r)
+		return r, r.Status, nil
+	}
+}
@@ -0,0 +1,75 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudInstanceSnapshotConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_instance" "instance" {
+  project_id  = "%s"
+  name        = "terraform_testacc_instance"
+  region      = "GRA1"
+  flavor_name = "s1-2"
+  image_name  = "Ubuntu 16.04"
+}
+
+resource "ovh_publiccloud_instance_snapshot" "snapshot" {
+  project_id  = "${ovh_publiccloud_instance.instance.project_id}"
+  instance_id = "${ovh_publiccloud_instance.instance.id}"
+  name        = "terraform_testacc_snapshot"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudInstanceSnapshot_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudInstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudInstanceSnapshotConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudInstanceSnapshotExists("ovh_publiccloud_instance_snapshot.snapshot", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_instance_snapshot.snapshot", "status", "active"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_instance_snapshot.snapshot", "region", "GRA1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudInstanceSnapshotExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return pcisExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckPublicCloudInstanceSnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_publiccloud_instance_snapshot" {
+			continue
+		}
+
+		err := pcisExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Public Cloud Instance Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+	return testAccCheckPublicCloudInstanceDestroy(s)
+}
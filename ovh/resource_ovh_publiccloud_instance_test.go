@@ -0,0 +1,115 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudInstanceConfig = `
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+  project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+  project_id = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id    = 0
+  name       = "terraform_testacc_private_net"
+  regions    = ["GRA1"]
+}
+
+resource "ovh_publiccloud_private_network_subnet" "subnet" {
+  project_id = "${ovh_publiccloud_private_network.network.project_id}"
+  network_id = "${ovh_publiccloud_private_network.network.id}"
+  region     = "GRA1"
+  start      = "192.168.168.100"
+  end        = "192.168.168.200"
+  network    = "192.168.168.0/24"
+  dhcp       = true
+  no_gateway = true
+}
+
+resource "ovh_publiccloud_instance" "instance" {
+  project_id  = "${ovh_publiccloud_private_network_subnet.subnet.project_id}"
+  name        = "%s"
+  region      = "GRA1"
+  flavor_name = "s1-2"
+  image_name  = "Ubuntu 16.04"
+
+  networks {
+    network_id = "${ovh_publiccloud_private_network.network.id}"
+    ip         = "192.168.168.10"
+  }
+}
+`
+
+func TestAccPublicCloudInstance_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudInstanceConfig, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"), "terraform_testacc_instance"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudInstanceExists("ovh_publiccloud_instance.instance", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_instance.instance", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_instance.instance", "monthly_billing", "false"),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_instance.instance", "flavor_id"),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_instance.instance", "image_id"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_instance.instance", "ip_addresses.0.ip", "192.168.168.10"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudInstanceConfig, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"), "terraform_testacc_instance_renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_publiccloud_instance.instance", "name", "terraform_testacc_instance_renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudInstancePreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckPublicCloudExists(t)
+}
+
+func testAccCheckPublicCloudInstanceExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		if rs.Primary.Attributes["project_id"] == "" {
+			return fmt.Errorf("No Project ID is set")
+		}
+
+		return pciExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckPublicCloudInstanceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_publiccloud_instance" {
+			continue
+		}
+
+		err := pciExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Public Cloud Instance %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -5,12 +5,17 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/ovh/go-ovh/ovh"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/extensions/provider"
 	"github.com/rackspace/gophercloud/openstack/networking/v2/networks"
+	"github.com/rackspace/gophercloud/pagination"
 	"log"
-	"os"
+	"regexp"
+	"strconv"
 	"time"
 )
 
+var pcpnID = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
 func resourcePublicCloudPrivateNetwork() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublicCloudPrivateNetworkCreate,
@@ -19,7 +24,18 @@ func resourcePublicCloudPrivateNetwork() *schema.Resource {
 		Delete: resourcePublicCloudPrivateNetworkDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				d.Set("project_id", os.Getenv("OVH_PROJECT_ID"))
+				params := pcpnID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor network id from id %q, expected projectId/networkId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudPrivateNetworkRead(d, meta); err != nil {
+					return nil, err
+				}
+
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -103,12 +119,13 @@ type pcpnUpdateParams struct {
 }
 
 type pcpnRegion struct {
-	Status string `json:"status"`
-	Region string `json:"region"`
+	Status      string `json:"status"`
+	Region      string `json:"region"`
+	OpenstackId string `json:"openstackId"`
 }
 
 func (p *pcpnRegion) String() string {
-	return fmt.Sprintf("Status:%s, Region: %s", p.Status, p.Region)
+	return fmt.Sprintf("Status:%s, Region: %s, OpenstackId: %s", p.Status, p.Region, p.OpenstackId)
 }
 
 type pcpnResponse struct {
@@ -247,16 +264,13 @@ func readPcpn(config *Config, d *schema.ResourceData, r *pcpnResponse) error {
 		regions_status = append(regions_status, region)
 		regions = append(regions, fmt.Sprintf(r.Regions[i].Region))
 
-		netClient, err := config.networkingV2Client(region["region"].(string))
+		osId, err := pcpnOpenstackId(config, r.Regions[i], r.Vlanid)
 		if err != nil {
-			return fmt.Errorf("Error getting Openstack networking client: %s", err)
+			return err
 		}
-
-		osId, err := networks.IDFromName(netClient, r.Name)
-		if err != nil {
-			return fmt.Errorf("Error reading net id from Openstack: %s", err)
+		if osId != "" {
+			netIds[r.Regions[i].Region] = osId
 		}
-		netIds[region["region"].(string)] = osId
 	}
 	d.Set("regions_status", regions_status)
 	d.Set("regions", regions)
@@ -266,6 +280,57 @@ func readPcpn(config *Config, d *schema.ResourceData, r *pcpnResponse) error {
 	return nil
 }
 
+// pcpnOpenstackId returns the openstack network id of a private network in a region.
+// The id returned by the OVH API is used when available. Otherwise the network
+// is looked up on Neutron by its vlan segmentation id, which requires openstack
+// credentials. An empty id is returned if it can't be resolved.
+func pcpnOpenstackId(config *Config, region *pcpnRegion, vlanId int) (string, error) {
+	if region.OpenstackId != "" {
+		return region.OpenstackId, nil
+	}
+
+	if config.OSClient == nil {
+		log.Printf("[WARN] No openstack id returned for private network in region %s and no openstack client configured", region.Region)
+		return "", nil
+	}
+
+	netClient, err := config.networkingV2Client(region.Region)
+	if err != nil {
+		return "", fmt.Errorf("Error getting Openstack networking client: %s", err)
+	}
+
+	segmentationId := strconv.Itoa(vlanId)
+	osIds := make([]string, 0)
+
+	pager := networks.List(netClient, networks.ListOpts{})
+	err = pager.EachPage(func(page pagination.Page) (bool, error) {
+		nets, err := provider.ExtractList(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, n := range nets {
+			if n.SegmentationID == segmentationId {
+				osIds = append(osIds, n.ID)
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error listing networks from Openstack in region %s: %s", region.Region, err)
+	}
+
+	switch len(osIds) {
+	case 0:
+		log.Printf("[WARN] No openstack network found with segmentation id %s in region %s", segmentationId, region.Region)
+		return "", nil
+	case 1:
+		return osIds[0], nil
+	default:
+		return "", fmt.Errorf("Found %d openstack networks with segmentation id %s in region %s", len(osIds), segmentationId, region.Region)
+	}
+}
+
 func resourcePublicCloudPrivateNetworkDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -3,20 +3,45 @@ package ovh
 import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mitchellh/mapstructure"
 	"github.com/ovh/go-ovh/ovh"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/networking/v2/subnets"
 	"log"
-	"os"
+	"regexp"
 )
 
+var pcpnsID = regexp.MustCompile("^([^/]+)/([^/]+)/([^/]+)$")
+
 func resourcePublicCloudPrivateNetworkSubnet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublicCloudPrivateNetworkSubnetCreate,
 		Read:   resourcePublicCloudPrivateNetworkSubnetRead,
+		Update: resourcePublicCloudPrivateNetworkSubnetUpdate,
 		Delete: resourcePublicCloudPrivateNetworkSubnetDelete,
+
+		CustomizeDiff: resourcePublicCloudPrivateNetworkSubnetCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				d.Set("project_id", os.Getenv("OVH_PROJECT_ID"))
-				d.Set("network_id", os.Getenv("OVH_NETWORK_ID"))
+				params := pcpnsID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id, network id nor subnet id from id %q, expected projectId/networkId/subnetId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.Set("network_id", params[2])
+				d.SetId(params[3])
+
+				if err := pcpnsImportIPVersion(d, meta.(*Config)); err != nil {
+					return nil, err
+				}
+
+				if err := resourcePublicCloudPrivateNetworkSubnetRead(d, meta); err != nil {
+					return nil, err
+				}
+
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -40,19 +65,91 @@ func resourcePublicCloudPrivateNetworkSubnet() *schema.Resource {
 				Default:  false,
 			},
 			"start": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateIP,
+				ConflictsWith: []string{"allocation_pools"},
 			},
 			"end": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateIP,
+				ConflictsWith: []string{"allocation_pools"},
+			},
+			"allocation_pools": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIP,
+						},
+						"end": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIP,
+						},
+					},
+				},
+			},
+			"dns_nameservers": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIP,
+				},
+			},
+			"host_routes": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDR,
+						},
+						"nexthop": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIP,
+						},
+					},
+				},
 			},
 			"network": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDR,
+			},
+			"ip_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      4,
+				ValidateFunc: validateIPVersion,
+			},
+			"ipv6_address_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"slaac", "dhcpv6-stateful", "dhcpv6-stateless"}, false),
+			},
+			"ipv6_ra_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"slaac", "dhcpv6-stateful", "dhcpv6-stateless"}, false),
 			},
 			"region": &schema.Schema{
 				Type:     schema.TypeString,
@@ -65,10 +162,14 @@ func resourcePublicCloudPrivateNetworkSubnet() *schema.Resource {
 				ForceNew: true,
 				Default:  false,
 			},
+			// only IPv6 subnets, created through Neutron, can be given
+			// a gateway, the OVH API picks the one of IPv4 subnets
 			"gateway_ip": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIP,
 			},
 
 			"cidr": &schema.Schema{
@@ -159,9 +260,96 @@ func (p *pcpnsResponse) String() string {
 	return fmt.Sprintf("PCPNSResponse[Id: %s, GatewayIp: %s, Cidr: %s, IPPools: %s]", p.Id, p.GatewayIp, p.Cidr, p.IPPools)
 }
 
+func resourcePublicCloudPrivateNetworkSubnetCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	version := d.Get("ip_version").(int)
+	network := d.Get("network").(string)
+
+	ipv6Modes := d.Get("ipv6_address_mode").(string) != "" || d.Get("ipv6_ra_mode").(string) != ""
+	if version != 6 && ipv6Modes {
+		return fmt.Errorf("[ERROR] ipv6_address_mode and ipv6_ra_mode can only be set with ip_version 6")
+	}
+	if ipv6Modes && !d.Get("dhcp").(bool) {
+		return fmt.Errorf("[ERROR] ipv6_address_mode and ipv6_ra_mode require dhcp to be enabled")
+	}
+
+	start := d.Get("start").(string)
+	end := d.Get("end").(string)
+	if (start == "") != (end == "") {
+		return fmt.Errorf("[ERROR] start and end must be set together")
+	}
+
+	pools := pcpnsAllocationPools(d.Get("allocation_pools").([]interface{}))
+	if len(pools) == 0 {
+		// the OVH API needs an ip range to create an IPv4 subnet
+		if d.Id() == "" && version == 4 && start == "" {
+			return fmt.Errorf("[ERROR] either start and end or allocation_pools must be set for an IPv4 subnet")
+		}
+
+		pools = []subnets.AllocationPool{
+			subnets.AllocationPool{
+				Start: start,
+				End:   end,
+			},
+		}
+	}
+
+	// values may be unknown until apply if they are interpolated
+	if network == "" {
+		return nil
+	}
+
+	if ipVersion(network) != version {
+		return fmt.Errorf("[ERROR] %s is not an IPv%d network", network, version)
+	}
+
+	for _, pool := range pools {
+		if pool.Start == "" || pool.End == "" {
+			continue
+		}
+		if ipVersion(pool.Start) != version || ipVersion(pool.End) != version {
+			return fmt.Errorf("[ERROR] ip range %s-%s is not an IPv%d range", pool.Start, pool.End, version)
+		}
+		if err := validateIPRange(network, pool.Start, pool.End); err != nil {
+			return fmt.Errorf("[ERROR] invalid ip range: %s", err)
+		}
+	}
+
+	for _, route := range pcpnsHostRoutes(d.Get("host_routes").([]interface{})) {
+		if ipVersion(route.DestinationCIDR) != version || ipVersion(route.NextHop) != version {
+			return fmt.Errorf("[ERROR] host route %s via %s is not an IPv%d route", route.DestinationCIDR, route.NextHop, version)
+		}
+	}
+
+	if d.HasChange("gateway_ip") {
+		if gateway := d.Get("gateway_ip").(string); gateway != "" {
+			if version != 6 {
+				return fmt.Errorf("[ERROR] gateway_ip can only be set with ip_version 6, the gateway of IPv4 subnets is set by the OVH API")
+			}
+			if ipVersion(gateway) != version {
+				return fmt.Errorf("[ERROR] gateway_ip %s is not an IPv%d address", gateway, version)
+			}
+			for _, pool := range pools {
+				if err := validateGatewayIP(network, pool.Start, pool.End, gateway); err != nil {
+					return fmt.Errorf("[ERROR] invalid gateway_ip: %s", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourcePublicCloudPrivateNetworkSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("ip_version").(int) == 6 {
+		return pcpnsIPv6Create(d, config)
+	}
+
+	if config.OSClient == nil && pcpnsHasOpenstackOpts(d) {
+		return fmt.Errorf("[ERROR] allocation_pools, dns_nameservers and host_routes require the openstack provider credentials to be configured")
+	}
+
 	projectId := d.Get("project_id").(string)
 	networkId := d.Get("network_id").(string)
 	params := &pcpnsCreateParams{
@@ -175,6 +363,14 @@ func resourcePublicCloudPrivateNetworkSubnetCreate(d *schema.ResourceData, meta
 		Region:    d.Get("region").(string),
 	}
 
+	// the OVH API only handles a single pool, the others are
+	// added afterwards through Neutron
+	pools := pcpnsAllocationPools(d.Get("allocation_pools").([]interface{}))
+	if len(pools) > 0 {
+		params.Start = pools[0].Start
+		params.End = pools[0].End
+	}
+
 	r := &pcpnsResponse{}
 
 	log.Printf("[DEBUG] Will create public cloud private network subnet: %s", params)
@@ -191,12 +387,58 @@ func resourcePublicCloudPrivateNetworkSubnetCreate(d *schema.ResourceData, meta
 	//set id
 	d.SetId(r.Id)
 
-	return nil
+	if pcpnsHasOpenstackOpts(d) {
+		opts := pcpnsUpdateOpts{}
+		if nameservers := pcpnsDNSNameservers(d.Get("dns_nameservers").([]interface{})); len(nameservers) > 0 {
+			opts.DNSNameservers = nameservers
+		}
+		if routes := pcpnsHostRoutes(d.Get("host_routes").([]interface{})); len(routes) > 0 {
+			opts.HostRoutes = routes
+		}
+		if len(pools) > 1 {
+			opts.AllocationPools = pools
+		}
+
+		if err := pcpnsOpenstackUpdate(d, config, opts); err != nil {
+			return err
+		}
+	}
+
+	return resourcePublicCloudPrivateNetworkSubnetRead(d, meta)
+}
+
+func resourcePublicCloudPrivateNetworkSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// removed attributes are sent as empty lists to be cleared
+	opts := pcpnsUpdateOpts{}
+	if d.HasChange("dns_nameservers") {
+		opts.DNSNameservers = pcpnsDNSNameservers(d.Get("dns_nameservers").([]interface{}))
+	}
+	if d.HasChange("host_routes") {
+		opts.HostRoutes = pcpnsHostRoutes(d.Get("host_routes").([]interface{}))
+	}
+
+	if opts.DNSNameservers != nil || opts.HostRoutes != nil {
+		if config.OSClient == nil {
+			return fmt.Errorf("[ERROR] dns_nameservers and host_routes require the openstack provider credentials to be configured")
+		}
+
+		if err := pcpnsOpenstackUpdate(d, config, opts); err != nil {
+			return err
+		}
+	}
+
+	return resourcePublicCloudPrivateNetworkSubnetRead(d, meta)
 }
 
 func resourcePublicCloudPrivateNetworkSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("ip_version").(int) == 6 {
+		return pcpnsIPv6Read(d, config)
+	}
+
 	projectId := d.Get("project_id").(string)
 	networkId := d.Get("network_id").(string)
 
@@ -208,14 +450,40 @@ func resourcePublicCloudPrivateNetworkSubnetRead(d *schema.ResourceData, meta in
 
 	err := config.OVHClient.Get(endpoint, &r)
 	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Private network %s not found in project %s, removing subnet %s from state", networkId, projectId, d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
 	}
 
+	if findPcpns(r, d.Id()) == nil {
+		log.Printf("[WARN] Subnet %s not found in private network %s, removing from state", d.Id(), networkId)
+		d.SetId("")
+		return nil
+	}
+
 	err = readPcpns(d, r)
 	if err != nil {
 		return err
 	}
 
+	// dns nameservers, host routes and extra allocation pools are
+	// only known by Neutron
+	if config.OSClient != nil {
+		netClient, err := config.networkingV2Client(d.Get("region").(string))
+		if err != nil {
+			return fmt.Errorf("Error getting Openstack networking client: %s", err)
+		}
+
+		s, err := subnets.Get(netClient, d.Id()).Extract()
+		if err != nil {
+			return fmt.Errorf("[ERROR] reading subnet %s from openstack: %s", d.Id(), err)
+		}
+		readPcpnsOpenstack(d, s)
+	}
+
 	log.Printf("[DEBUG] Read Public Cloud Private Network %v", r)
 	return nil
 }
@@ -237,6 +505,7 @@ func readPcpns(d *schema.ResourceData, rs []*pcpnsResponse) error {
 
 	d.Set("gateway_ip", r.GatewayIp)
 	d.Set("cidr", r.Cidr)
+	d.Set("ip_version", ipVersion(r.Cidr))
 
 	ippools := make([]map[string]interface{}, 0)
 	for i := range r.IPPools {
@@ -249,11 +518,13 @@ func readPcpns(d *schema.ResourceData, rs []*pcpnsResponse) error {
 		ippools = append(ippools, ippool)
 	}
 
-	d.Set("network", ippools[0]["network"])
-	d.Set("region", ippools[0]["region"])
-	d.Set("dhcp", ippools[0]["dhcp"])
-	d.Set("start", ippools[0]["start"])
-	d.Set("end", ippools[0]["end"])
+	if len(ippools) > 0 {
+		d.Set("network", ippools[0]["network"])
+		d.Set("region", ippools[0]["region"])
+		d.Set("dhcp", ippools[0]["dhcp"])
+		d.Set("start", ippools[0]["start"])
+		d.Set("end", ippools[0]["end"])
+	}
 	d.Set("ip_pools", ippools)
 
 	if r.GatewayIp == "" {
@@ -269,13 +540,17 @@ func readPcpns(d *schema.ResourceData, rs []*pcpnsResponse) error {
 func resourcePublicCloudPrivateNetworkSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("ip_version").(int) == 6 {
+		return pcpnsIPv6Delete(d, config)
+	}
+
 	projectId := d.Get("project_id").(string)
 	networkId := d.Get("network_id").(string)
 	id := d.Id()
 
 	log.Printf("[DEBUG] Will delete public cloud private network subnet for project: %s, network: %s, id: %s", projectId, networkId, id)
 
-	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet/%s", projectId, id, id)
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet/%s", projectId, networkId, id)
 
 	err := config.OVHClient.Delete(endpoint, nil)
 	if err != nil {
@@ -288,6 +563,363 @@ func resourcePublicCloudPrivateNetworkSubnetDelete(d *schema.ResourceData, meta
 	return nil
 }
 
+// IPv6 subnets are not supported by the OVH API, they are managed
+// through Neutron on the openstack network of the private network region.
+type pcpnsIPv6CreateOpts struct {
+	subnets.CreateOpts
+	IPv6AddressMode string
+	IPv6RAMode      string
+}
+
+func (opts pcpnsIPv6CreateOpts) ToSubnetCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToSubnetCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	s := b["subnet"].(map[string]interface{})
+	if opts.IPv6AddressMode != "" {
+		s["ipv6_address_mode"] = opts.IPv6AddressMode
+	}
+	if opts.IPv6RAMode != "" {
+		s["ipv6_ra_mode"] = opts.IPv6RAMode
+	}
+
+	return b, nil
+}
+
+// pcpnsOpenstackNetworkId returns the openstack network id of the private
+// network in the subnet region.
+func pcpnsOpenstackNetworkId(config *Config, projectId, networkId, region string) (string, error) {
+	r := &pcpnResponse{}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, networkId)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	for i := range r.Regions {
+		if r.Regions[i].Region != region {
+			continue
+		}
+
+		osId, err := pcpnOpenstackId(config, r.Regions[i], r.Vlanid)
+		if err != nil {
+			return "", err
+		}
+		if osId == "" {
+			return "", fmt.Errorf("[ERROR] couldn't resolve openstack network id of private network %s in region %s", networkId, region)
+		}
+		return osId, nil
+	}
+
+	return "", fmt.Errorf("[ERROR] private network %s is not available in region %s", networkId, region)
+}
+
+// pcpnsImportIPVersion sets the ip version of an imported subnet. IPv4
+// subnets are listed by the OVH API, IPv6 ones only exist in Neutron and
+// are looked up in each region of the private network.
+func pcpnsImportIPVersion(d *schema.ResourceData, config *Config) error {
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+
+	rs := []*pcpnsResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet", projectId, networkId)
+
+	err := config.OVHClient.Get(endpoint, &rs)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	if r := findPcpns(rs, d.Id()); r != nil {
+		d.Set("ip_version", ipVersion(r.Cidr))
+		return nil
+	}
+
+	if config.OSClient == nil {
+		return fmt.Errorf("[ERROR] subnet %s not found, IPv6 subnets can only be imported with the openstack provider credentials configured", d.Id())
+	}
+
+	n := &pcpnResponse{}
+	endpoint = fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, networkId)
+
+	err = config.OVHClient.Get(endpoint, n)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	for _, region := range n.Regions {
+		netClient, err := config.networkingV2Client(region.Region)
+		if err != nil {
+			return fmt.Errorf("Error getting Openstack networking client: %s", err)
+		}
+
+		s, err := subnets.Get(netClient, d.Id()).Extract()
+		if err != nil {
+			if e, ok := err.(*gophercloud.UnexpectedResponseCodeError); ok && e.Actual == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] reading subnet %s from openstack in region %s: %s", d.Id(), region.Region, err)
+		}
+
+		log.Printf("[DEBUG] Found IPv%d subnet %s in region %s", s.IPVersion, d.Id(), region.Region)
+		d.Set("ip_version", s.IPVersion)
+		d.Set("region", region.Region)
+		return nil
+	}
+
+	return fmt.Errorf("[ERROR] subnet %s not found in private network %s", d.Id(), networkId)
+}
+
+func pcpnsIPv6Create(d *schema.ResourceData, config *Config) error {
+	if config.OSClient == nil {
+		return fmt.Errorf("[ERROR] IPv6 subnets require the openstack provider credentials to be configured")
+	}
+
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+	region := d.Get("region").(string)
+
+	osNetworkId, err := pcpnsOpenstackNetworkId(config, projectId, networkId, region)
+	if err != nil {
+		return err
+	}
+
+	netClient, err := config.networkingV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error getting Openstack networking client: %s", err)
+	}
+
+	dhcp := d.Get("dhcp").(bool)
+	opts := pcpnsIPv6CreateOpts{
+		CreateOpts: subnets.CreateOpts{
+			NetworkID:      osNetworkId,
+			CIDR:           d.Get("network").(string),
+			IPVersion:      6,
+			EnableDHCP:     &dhcp,
+			GatewayIP:      d.Get("gateway_ip").(string),
+			NoGateway:      d.Get("no_gateway").(bool),
+			DNSNameservers: pcpnsDNSNameservers(d.Get("dns_nameservers").([]interface{})),
+			HostRoutes:     pcpnsHostRoutes(d.Get("host_routes").([]interface{})),
+		},
+		IPv6AddressMode: d.Get("ipv6_address_mode").(string),
+		IPv6RAMode:      d.Get("ipv6_ra_mode").(string),
+	}
+
+	// Neutron allocates the whole network when no pool is given
+	if pools := pcpnsAllocationPools(d.Get("allocation_pools").([]interface{})); len(pools) > 0 {
+		opts.AllocationPools = pools
+	} else if start, end := d.Get("start").(string), d.Get("end").(string); start != "" && end != "" {
+		opts.AllocationPools = []subnets.AllocationPool{
+			subnets.AllocationPool{Start: start, End: end},
+		}
+	}
+
+	log.Printf("[DEBUG] Will create public cloud private network IPv6 subnet: %+v", opts)
+
+	s, err := subnets.Create(netClient, opts).Extract()
+	if err != nil {
+		return fmt.Errorf("[ERROR] creating IPv6 subnet on openstack network %s in region %s: %s", osNetworkId, region, err)
+	}
+
+	log.Printf("[DEBUG] Created Private Network IPv6 Subnet %s", s.ID)
+
+	//set id
+	d.SetId(s.ID)
+
+	return nil
+}
+
+func pcpnsIPv6Read(d *schema.ResourceData, config *Config) error {
+	if config.OSClient == nil {
+		return fmt.Errorf("[ERROR] IPv6 subnets require the openstack provider credentials to be configured")
+	}
+
+	region := d.Get("region").(string)
+
+	netClient, err := config.networkingV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error getting Openstack networking client: %s", err)
+	}
+
+	res := subnets.Get(netClient, d.Id())
+
+	s, err := res.Extract()
+	if err != nil {
+		if e, ok := err.(*gophercloud.UnexpectedResponseCodeError); ok && e.Actual == 404 {
+			log.Printf("[WARN] IPv6 subnet %s not found in region %s, removing from state", d.Id(), region)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] reading IPv6 subnet %s in region %s: %s", d.Id(), region, err)
+	}
+
+	// the ipv6 modes aren't part of the gophercloud subnet
+	var modes struct {
+		Subnet struct {
+			IPv6AddressMode string `mapstructure:"ipv6_address_mode"`
+			IPv6RAMode      string `mapstructure:"ipv6_ra_mode"`
+		} `mapstructure:"subnet"`
+	}
+	if err := mapstructure.Decode(res.Body, &modes); err != nil {
+		return fmt.Errorf("[ERROR] reading ipv6 modes of subnet %s in region %s: %s", d.Id(), region, err)
+	}
+
+	r := &pcpnsResponse{
+		Id:        s.ID,
+		GatewayIp: s.GatewayIP,
+		Cidr:      s.CIDR,
+		IPPools:   make([]*IPPool, 0),
+	}
+	for _, p := range s.AllocationPools {
+		r.IPPools = append(r.IPPools, &IPPool{
+			Network: s.CIDR,
+			Region:  region,
+			Dhcp:    s.EnableDHCP,
+			Start:   p.Start,
+			End:     p.End,
+		})
+	}
+
+	log.Printf("[DEBUG] Read Public Cloud Private Network IPv6 Subnet %s", r)
+	if err := readPcpns(d, []*pcpnsResponse{r}); err != nil {
+		return err
+	}
+
+	readPcpnsOpenstack(d, s)
+	d.Set("ipv6_address_mode", modes.Subnet.IPv6AddressMode)
+	d.Set("ipv6_ra_mode", modes.Subnet.IPv6RAMode)
+	return nil
+}
+
+func pcpnsIPv6Delete(d *schema.ResourceData, config *Config) error {
+	if config.OSClient == nil {
+		return fmt.Errorf("[ERROR] IPv6 subnets require the openstack provider credentials to be configured")
+	}
+
+	region := d.Get("region").(string)
+
+	netClient, err := config.networkingV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error getting Openstack networking client: %s", err)
+	}
+
+	id := d.Id()
+
+	err = subnets.Delete(netClient, id).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("[ERROR] deleting IPv6 subnet %s in region %s: %s", id, region, err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[DEBUG] Deleted Public Cloud Private Network IPv6 Subnet %s", id)
+	return nil
+}
+
+// pcpnsUpdateOpts updates the subnet attributes which are not handled
+// by the OVH API. The OVH subnet id is the Neutron subnet id.
+type pcpnsUpdateOpts struct {
+	DNSNameservers  []string
+	HostRoutes      []subnets.HostRoute
+	AllocationPools []subnets.AllocationPool
+}
+
+func (opts pcpnsUpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	s := make(map[string]interface{})
+	if opts.DNSNameservers != nil {
+		s["dns_nameservers"] = opts.DNSNameservers
+	}
+	if opts.HostRoutes != nil {
+		s["host_routes"] = opts.HostRoutes
+	}
+	if opts.AllocationPools != nil {
+		s["allocation_pools"] = opts.AllocationPools
+	}
+
+	return map[string]interface{}{"subnet": s}, nil
+}
+
+func pcpnsOpenstackUpdate(d *schema.ResourceData, config *Config, opts pcpnsUpdateOpts) error {
+	region := d.Get("region").(string)
+
+	netClient, err := config.networkingV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error getting Openstack networking client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Will update openstack subnet %s in region %s: %+v", d.Id(), region, opts)
+
+	_, err = subnets.Update(netClient, d.Id(), opts).Extract()
+	if err != nil {
+		return fmt.Errorf("[ERROR] updating subnet %s on openstack in region %s: %s", d.Id(), region, err)
+	}
+
+	return nil
+}
+
+func readPcpnsOpenstack(d *schema.ResourceData, s *subnets.Subnet) {
+	pools := make([]map[string]interface{}, 0)
+	for _, p := range s.AllocationPools {
+		pools = append(pools, map[string]interface{}{
+			"start": p.Start,
+			"end":   p.End,
+		})
+	}
+
+	routes := make([]map[string]interface{}, 0)
+	for _, r := range s.HostRoutes {
+		routes = append(routes, map[string]interface{}{
+			"destination": r.DestinationCIDR,
+			"nexthop":     r.NextHop,
+		})
+	}
+
+	d.Set("allocation_pools", pools)
+	d.Set("host_routes", routes)
+	d.Set("dns_nameservers", s.DNSNameservers)
+}
+
+func pcpnsHasOpenstackOpts(d *schema.ResourceData) bool {
+	return len(d.Get("allocation_pools").([]interface{})) > 1 ||
+		len(d.Get("dns_nameservers").([]interface{})) > 0 ||
+		len(d.Get("host_routes").([]interface{})) > 0
+}
+
+func pcpnsAllocationPools(vs []interface{}) []subnets.AllocationPool {
+	pools := make([]subnets.AllocationPool, 0)
+	for _, v := range vs {
+		p := v.(map[string]interface{})
+		pools = append(pools, subnets.AllocationPool{
+			Start: p["start"].(string),
+			End:   p["end"].(string),
+		})
+	}
+	return pools
+}
+
+func pcpnsHostRoutes(vs []interface{}) []subnets.HostRoute {
+	routes := make([]subnets.HostRoute, 0)
+	for _, v := range vs {
+		r := v.(map[string]interface{})
+		routes = append(routes, subnets.HostRoute{
+			DestinationCIDR: r["destination"].(string),
+			NextHop:         r["nexthop"].(string),
+		})
+	}
+	return routes
+}
+
+func pcpnsDNSNameservers(vs []interface{}) []string {
+	nameservers := make([]string, 0)
+	for _, v := range vs {
+		nameservers = append(nameservers, v.(string))
+	}
+	return nameservers
+}
+
 func pcpnsExists(projectId, networkId, id string, c *ovh.Client) error {
 	r := []*pcpnsResponse{}
 
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"os"
+	"regexp"
 	"testing"
 )
 
@@ -33,6 +34,148 @@ resource "ovh_publiccloud_private_network_subnet" "subnet" {
 }
 `, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
 
+var testAccPublicCloudPrivateNetworkSubnetConfig_networkOnly = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+	project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+	project_id  = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id     = 0
+  name        = "terraform_testacc_private_net"
+  regions     = ["GRA1", "BHS1"]
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudPrivateNetworkSubnetConfig_openstackOpts = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+	project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+	project_id  = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id     = 0
+  name        = "terraform_testacc_private_net"
+  regions     = ["GRA1", "BHS1"]
+}
+
+resource "ovh_publiccloud_private_network_subnet" "subnet" {
+	project_id      = "${ovh_publiccloud_private_network.network.project_id}"
+  network_id      = "${ovh_publiccloud_private_network.network.id}"
+  region          = "GRA1"
+  network         = "192.168.168.0/24"
+  dhcp            = true
+  dns_nameservers = ["192.168.168.2", "192.168.168.3"]
+
+  allocation_pools {
+    start = "192.168.168.100"
+    end   = "192.168.168.150"
+  }
+
+  allocation_pools {
+    start = "192.168.168.200"
+    end   = "192.168.168.250"
+  }
+
+  host_routes {
+    destination = "10.0.0.0/8"
+    nexthop     = "192.168.168.254"
+  }
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudPrivateNetworkSubnetConfig_openstackOptsUpdated = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+	project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+	project_id  = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id     = 0
+  name        = "terraform_testacc_private_net"
+  regions     = ["GRA1", "BHS1"]
+}
+
+resource "ovh_publiccloud_private_network_subnet" "subnet" {
+	project_id      = "${ovh_publiccloud_private_network.network.project_id}"
+  network_id      = "${ovh_publiccloud_private_network.network.id}"
+  region          = "GRA1"
+  network         = "192.168.168.0/24"
+  dhcp            = true
+  dns_nameservers = ["192.168.168.4"]
+
+  allocation_pools {
+    start = "192.168.168.100"
+    end   = "192.168.168.150"
+  }
+
+  allocation_pools {
+    start = "192.168.168.200"
+    end   = "192.168.168.250"
+  }
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudPrivateNetworkSubnetConfig_ipv6 = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+	project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+	project_id  = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id     = 0
+  name        = "terraform_testacc_private_net"
+  regions     = ["GRA1", "BHS1"]
+}
+
+resource "ovh_publiccloud_private_network_subnet" "subnet" {
+	project_id        = "${ovh_publiccloud_private_network.network.project_id}"
+  network_id        = "${ovh_publiccloud_private_network.network.id}"
+  region            = "GRA1"
+  ip_version        = 6
+  ipv6_address_mode = "slaac"
+  ipv6_ra_mode      = "slaac"
+  network           = "fd00:168::/64"
+  dhcp              = true
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudPrivateNetworkSubnetConfig_invalidRange = fmt.Sprintf(`
+resource "ovh_publiccloud_private_network_subnet" "subnet" {
+	project_id = "%s"
+  network_id = "dummy"
+  region     = "GRA1"
+  start      = "192.168.168.200"
+  end        = "192.168.168.100"
+  network    = "192.168.168.0/24"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudPrivateNetworkSubnetConfig_ipv4Gateway = fmt.Sprintf(`
+resource "ovh_publiccloud_private_network_subnet" "subnet" {
+	project_id = "%s"
+  network_id = "dummy"
+  region     = "GRA1"
+  start      = "192.168.168.100"
+  end        = "192.168.168.200"
+  network    = "192.168.168.0/24"
+  gateway_ip = "192.168.168.1"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudPrivateNetworkSubnetConfig_missingRange = fmt.Sprintf(`
+resource "ovh_publiccloud_private_network_subnet" "subnet" {
+	project_id = "%s"
+  network_id = "dummy"
+  region     = "GRA1"
+  network    = "192.168.168.0/24"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
 func TestAccPublicCloudPrivateNetworkSubnet_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
@@ -51,6 +194,172 @@ func TestAccPublicCloudPrivateNetworkSubnet_basic(t *testing.T) {
 	})
 }
 
+// The subnet is removed while its network is kept, so that its deletion
+// isn't hidden by the deletion of the network.
+func TestAccPublicCloudPrivateNetworkSubnet_delete(t *testing.T) {
+	var projectId, networkId, id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkSubnetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudPrivateNetworkSubnetExists("ovh_publiccloud_private_network_subnet.subnet", t),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["ovh_publiccloud_private_network_subnet.subnet"]
+						projectId = rs.Primary.Attributes["project_id"]
+						networkId = rs.Primary.Attributes["network_id"]
+						id = rs.Primary.ID
+						return nil
+					},
+				),
+			},
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetConfig_networkOnly,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudPrivateNetworkExists("ovh_publiccloud_private_network.network", t),
+					func(s *terraform.State) error {
+						config := testAccProvider.Meta().(*Config)
+						if err := pcpnsExists(projectId, networkId, id, config.OVHClient); err == nil {
+							return fmt.Errorf("Public Cloud Private Network Subnet %s still exists", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudPrivateNetworkSubnet_openstackOpts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t)
+			testAccCheckOpenstackPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkSubnetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetConfig_openstackOpts,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudPrivateNetworkSubnetExists("ovh_publiccloud_private_network_subnet.subnet", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "allocation_pools.#", "2"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "dns_nameservers.#", "2"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "host_routes.0.destination", "10.0.0.0/8"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetConfig_openstackOptsUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudPrivateNetworkSubnetExists("ovh_publiccloud_private_network_subnet.subnet", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "dns_nameservers.#", "1"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "dns_nameservers.0", "192.168.168.4"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "host_routes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudPrivateNetworkSubnet_ipv6(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t)
+			testAccCheckOpenstackPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetConfig_ipv6,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "cidr", "fd00:168::/64"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "ip_version", "6"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnet.subnet", "allocation_pools.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_publiccloud_private_network_subnet.subnet",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPublicCloudPrivateNetworkSubnetImportId("ovh_publiccloud_private_network_subnet.subnet"),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudPrivateNetworkSubnet_invalidRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccPublicCloudPrivateNetworkSubnetConfig_invalidRange,
+				ExpectError: regexp.MustCompile("must be lower or equal than end"),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudPrivateNetworkSubnet_ipv4Gateway(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccPublicCloudPrivateNetworkSubnetConfig_ipv4Gateway,
+				ExpectError: regexp.MustCompile("gateway_ip can only be set with ip_version 6"),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudPrivateNetworkSubnet_missingRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccPublicCloudPrivateNetworkSubnetConfig_missingRange,
+				ExpectError: regexp.MustCompile("either start and end or allocation_pools must be set"),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudPrivateNetworkSubnet_importBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkSubnetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetConfig,
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_publiccloud_private_network_subnet.subnet",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPublicCloudPrivateNetworkSubnetImportId("ovh_publiccloud_private_network_subnet.subnet"),
+			},
+		},
+	})
+}
+
+func testAccPublicCloudPrivateNetworkSubnetImportId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["network_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t *testing.T) {
 	testAccPreCheck(t)
 	testAccCheckPublicCloudExists(t)
@@ -0,0 +1,364 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+)
+
+func resourcePublicCloudPrivateNetworkSubnets() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudPrivateNetworkSubnetsCreate,
+		Read:   resourcePublicCloudPrivateNetworkSubnetsRead,
+		Update: resourcePublicCloudPrivateNetworkSubnetsUpdate,
+		Delete: resourcePublicCloudPrivateNetworkSubnetsDelete,
+
+		CustomizeDiff: resourcePublicCloudPrivateNetworkSubnetsCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcpnID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor network id from id %q, expected projectId/networkId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.Set("network_id", params[2])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudPrivateNetworkSubnetsRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// subnets are created and deleted per region when it changes
+			"regions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dhcp": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"start": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIP,
+			},
+			"end": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIP,
+			},
+			"network": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDR,
+			},
+			"no_gateway": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"subnet_ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"gateway_ips": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePublicCloudPrivateNetworkSubnetsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("regions") {
+		for _, k := range []string{"subnet_ids", "gateway_ips"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+
+	network := d.Get("network").(string)
+	start := d.Get("start").(string)
+	end := d.Get("end").(string)
+
+	// values may be unknown until apply if they are interpolated
+	if network == "" || start == "" || end == "" {
+		return nil
+	}
+
+	for _, v := range []string{network, start, end} {
+		if ipVersion(v) != 4 {
+			return fmt.Errorf("[ERROR] %s is not an IPv4 address", v)
+		}
+	}
+
+	if err := validateIPRange(network, start, end); err != nil {
+		return fmt.Errorf("[ERROR] invalid ip range: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePublicCloudPrivateNetworkSubnetsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+
+	regions := regionsParamsFromSchema(d)
+	if len(regions) == 0 {
+		r := &pcpnResponse{}
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, networkId)
+
+		err := config.OVHClient.Get(endpoint, r)
+		if err != nil {
+			return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+		}
+
+		for i := range r.Regions {
+			regions = append(regions, r.Regions[i].Region)
+		}
+	}
+
+	ids := make(map[string]string)
+
+	for _, region := range regions {
+		id, err := pcpnsCreateInRegion(d, config, region)
+		if err != nil {
+			// keep track of the subnets already created so they can be destroyed
+			if len(ids) > 0 {
+				d.SetId(networkId)
+				d.Set("subnet_ids", ids)
+			}
+			return err
+		}
+
+		ids[region] = id
+	}
+
+	//set id
+	d.SetId(networkId)
+	d.Set("subnet_ids", ids)
+
+	return resourcePublicCloudPrivateNetworkSubnetsRead(d, meta)
+}
+
+func resourcePublicCloudPrivateNetworkSubnetsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+
+	if d.HasChange("regions") {
+		ids := make(map[string]string)
+		for region, id := range d.Get("subnet_ids").(map[string]interface{}) {
+			ids[region] = id.(string)
+		}
+
+		o, n := d.GetChange("regions")
+		oldRegions := o.(*schema.Set)
+		newRegions := n.(*schema.Set)
+
+		for _, v := range oldRegions.Difference(newRegions).List() {
+			region := v.(string)
+			if id, ok := ids[region]; ok {
+				if err := pcpnsDeleteInRegion(config, projectId, networkId, region, id); err != nil {
+					d.Set("subnet_ids", ids)
+					return err
+				}
+			}
+			delete(ids, region)
+		}
+
+		// regions whose subnet vanished are missing from subnet_ids
+		// and are created again as well
+		for _, v := range newRegions.List() {
+			region := v.(string)
+			if _, ok := ids[region]; ok {
+				continue
+			}
+
+			id, err := pcpnsCreateInRegion(d, config, region)
+			if err != nil {
+				d.Set("subnet_ids", ids)
+				return err
+			}
+			ids[region] = id
+		}
+
+		d.Set("subnet_ids", ids)
+	}
+
+	return resourcePublicCloudPrivateNetworkSubnetsRead(d, meta)
+}
+
+// pcpnsCreateInRegion creates the subnet of the network in the given region
+// and returns its id.
+func pcpnsCreateInRegion(d *schema.ResourceData, config *Config, region string) (string, error) {
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+
+	params := &pcpnsCreateParams{
+		ProjectId: projectId,
+		NetworkId: networkId,
+		Dhcp:      d.Get("dhcp").(bool),
+		NoGateway: d.Get("no_gateway").(bool),
+		Start:     d.Get("start").(string),
+		End:       d.Get("end").(string),
+		Network:   d.Get("network").(string),
+		Region:    region,
+	}
+
+	r := &pcpnsResponse{}
+
+	log.Printf("[DEBUG] Will create public cloud private network subnet: %s", params)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet", projectId, networkId)
+
+	err := config.OVHClient.Post(endpoint, params, r)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Created Private Network Subnet %s", r)
+	return r.Id, nil
+}
+
+// pcpnsDeleteInRegion deletes the subnet of the network in the given region.
+func pcpnsDeleteInRegion(config *Config, projectId, networkId, region, id string) error {
+	log.Printf("[DEBUG] Will delete public cloud private network subnet for project: %s, network: %s, region: %s, id: %s", projectId, networkId, region, id)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet/%s", projectId, networkId, id)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[DEBUG] subnet %s of network %s already deleted", id, networkId)
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	return nil
+}
+
+func resourcePublicCloudPrivateNetworkSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+
+	rs := []*pcpnsResponse{}
+
+	log.Printf("[DEBUG] Will read public cloud private network subnets for project: %s, network: %s", projectId, networkId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet", projectId, networkId)
+
+	err := config.OVHClient.Get(endpoint, &rs)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	// on import, every subnet of the network is adopted
+	subnets := make([]*pcpnsResponse, 0)
+	if ids := d.Get("subnet_ids").(map[string]interface{}); len(ids) > 0 {
+		for region, id := range ids {
+			r := findPcpns(rs, id.(string))
+			if r == nil {
+				log.Printf("[WARN] subnet %s of network %s in region %s not found", id, networkId, region)
+				continue
+			}
+			subnets = append(subnets, r)
+		}
+	} else {
+		subnets = rs
+	}
+
+	if len(subnets) == 0 {
+		log.Printf("[WARN] no subnet found for network %s, removing from state", networkId)
+		d.SetId("")
+		return nil
+	}
+
+	regions := make([]string, 0)
+	ids := make(map[string]string)
+	gateways := make(map[string]string)
+	for _, r := range subnets {
+		if len(r.IPPools) == 0 {
+			continue
+		}
+
+		region := r.IPPools[0].Region
+		if _, ok := ids[region]; ok {
+			return fmt.Errorf("[ERROR] network %s has several subnets in region %s, which ovh_publiccloud_private_network_subnets can't manage: use ovh_publiccloud_private_network_subnet instead", networkId, region)
+		}
+		regions = append(regions, region)
+		ids[region] = r.Id
+		gateways[region] = r.GatewayIp
+	}
+
+	first := subnets[0]
+	if len(first.IPPools) > 0 {
+		d.Set("network", first.IPPools[0].Network)
+		d.Set("dhcp", first.IPPools[0].Dhcp)
+		d.Set("start", first.IPPools[0].Start)
+		d.Set("end", first.IPPools[0].End)
+	}
+	d.Set("no_gateway", first.GatewayIp == "")
+
+	d.Set("regions", regions)
+	d.Set("subnet_ids", ids)
+	d.Set("gateway_ips", gateways)
+
+	log.Printf("[DEBUG] Read Public Cloud Private Network %s Subnets %v", networkId, ids)
+	return nil
+}
+
+func resourcePublicCloudPrivateNetworkSubnetsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	networkId := d.Get("network_id").(string)
+
+	for region, id := range d.Get("subnet_ids").(map[string]interface{}) {
+		if err := pcpnsDeleteInRegion(config, projectId, networkId, region, id.(string)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	log.Printf("[DEBUG] Deleted Public Cloud %s Private Network %s Subnets", projectId, networkId)
+	return nil
+}
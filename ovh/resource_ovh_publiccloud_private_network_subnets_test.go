@@ -0,0 +1,186 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudPrivateNetworkSubnetsConfig = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+	project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+	project_id  = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id     = 0
+  name        = "terraform_testacc_private_net"
+  regions     = ["GRA1", "BHS1"]
+}
+
+resource "ovh_publiccloud_private_network_subnets" "subnets" {
+	project_id = "${ovh_publiccloud_private_network.network.project_id}"
+  network_id = "${ovh_publiccloud_private_network.network.id}"
+  start      = "192.168.168.100"
+  end        = "192.168.168.200"
+  network    = "192.168.168.0/24"
+  dhcp       = true
+  no_gateway = false
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudPrivateNetworkSubnetsConfig_singleRegion = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+	project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+	project_id  = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id     = 0
+  name        = "terraform_testacc_private_net"
+  regions     = ["GRA1", "BHS1"]
+}
+
+resource "ovh_publiccloud_private_network_subnets" "subnets" {
+	project_id = "${ovh_publiccloud_private_network.network.project_id}"
+  network_id = "${ovh_publiccloud_private_network.network.id}"
+  regions    = ["GRA1"]
+  start      = "192.168.168.100"
+  end        = "192.168.168.200"
+  network    = "192.168.168.0/24"
+  dhcp       = true
+  no_gateway = false
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+var testAccPublicCloudPrivateNetworkSubnetsConfig_regions = fmt.Sprintf(`
+resource "ovh_vrack_publiccloud_attachment" "attach" {
+  vrack_id   = "%s"
+	project_id = "%s"
+}
+
+resource "ovh_publiccloud_private_network" "network" {
+	project_id  = "${ovh_vrack_publiccloud_attachment.attach.project_id}"
+  vlan_id     = 0
+  name        = "terraform_testacc_private_net"
+  regions     = ["GRA1", "BHS1"]
+}
+
+resource "ovh_publiccloud_private_network_subnets" "subnets" {
+	project_id = "${ovh_publiccloud_private_network.network.project_id}"
+  network_id = "${ovh_publiccloud_private_network.network.id}"
+  regions    = ["GRA1", "BHS1"]
+  start      = "192.168.168.100"
+  end        = "192.168.168.200"
+  network    = "192.168.168.0/24"
+  dhcp       = true
+  no_gateway = false
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudPrivateNetworkSubnets_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkSubnetsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudPrivateNetworkExists("ovh_publiccloud_private_network.network", t),
+					testAccCheckPublicCloudPrivateNetworkSubnetsExists("ovh_publiccloud_private_network_subnets.subnets", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnets.subnets", "regions.#", "2"),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_private_network_subnets.subnets", "gateway_ips.GRA1"),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_private_network_subnets.subnets", "gateway_ips.BHS1"),
+				),
+			},
+		},
+	})
+}
+
+// Adding a region creates its subnet without recreating the others.
+func TestAccPublicCloudPrivateNetworkSubnets_addRegion(t *testing.T) {
+	var graId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkSubnetPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkSubnetsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetsConfig_singleRegion,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnets.subnets", "regions.#", "1"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_private_network_subnets.subnets", "subnet_ids.%", "1"),
+					func(s *terraform.State) error {
+						graId = s.RootModule().Resources["ovh_publiccloud_private_network_subnets.subnets"].Primary.Attributes["subnet_ids.GRA1"]
+						return nil
+					},
+				),
+			},
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkSubnetsConfig_regions,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudPrivateNetworkSubnetsExists("ovh_publiccloud_private_network_subnets.subnets", t),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["ovh_publiccloud_private_network_subnets.subnets"].Primary.Attributes["subnet_ids.GRA1"]
+						if id != graId {
+							return fmt.Errorf("GRA1 subnet was recreated: %s != %s", id, graId)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudPrivateNetworkSubnetsExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["project_id"] == "" {
+			return fmt.Errorf("No Project ID is set")
+		}
+
+		for _, region := range []string{"GRA1", "BHS1"} {
+			id := rs.Primary.Attributes["subnet_ids."+region]
+			if id == "" {
+				return fmt.Errorf("No Subnet ID is set for region %s", region)
+			}
+
+			if err := pcpnsExists(rs.Primary.Attributes["project_id"], rs.Primary.Attributes["network_id"], id, config.OVHClient); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPublicCloudPrivateNetworkSubnetsDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_publiccloud_private_network_subnets" {
+			continue
+		}
+
+		for _, region := range []string{"GRA1", "BHS1"} {
+			err := pcpnsExists(rs.Primary.Attributes["project_id"], rs.Primary.Attributes["network_id"], rs.Primary.Attributes["subnet_ids."+region], config.OVHClient)
+			if err == nil {
+				return fmt.Errorf("VRack > Public Cloud Private Network Subnet still exists in region %s", region)
+			}
+		}
+
+	}
+	return nil
+}
@@ -39,6 +39,36 @@ func TestAccPublicCloudPrivateNetwork_basic(t *testing.T) {
 	})
 }
 
+func TestAccPublicCloudPrivateNetwork_importBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudPrivateNetworkPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudPrivateNetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudPrivateNetworkConfig,
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_publiccloud_private_network.network",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPublicCloudPrivateNetworkImportId("ovh_publiccloud_private_network.network"),
+			},
+		},
+	})
+}
+
+func testAccPublicCloudPrivateNetworkImportId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckPublicCloudPrivateNetworkPreCheck(t *testing.T) {
 	testAccPreCheck(t)
 	testAccCheckPublicCloudExists(t)
@@ -0,0 +1,213 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+	"strings"
+)
+
+var pcskID = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
+func resourcePublicCloudSshKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudSshKeyCreate,
+		Read:   resourcePublicCloudSshKeyRead,
+		Delete: resourcePublicCloudSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcskID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor ssh key id from id %q, expected projectId/sshKeyId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudSshKeyRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				// the API doesn't tell regional keys apart from keys
+				// available in a single region project
+				if regions := d.Get("regions").(*schema.Set).List(); len(regions) == 1 {
+					d.Set("region", regions[0])
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"public_key": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSSHPublicKey,
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSpace(v.(string))
+				},
+			},
+			// keys are available in all regions when no region is set
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// keys imported from a single region project get
+					// their region set even if they aren't regional
+					regions := d.Get("regions").(*schema.Set)
+					return new == "" && regions.Len() == 1 && regions.Contains(old)
+				},
+			},
+
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"regions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+// Params
+type pcskCreateParams struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"publicKey"`
+	Region    string `json:"region,omitempty"`
+}
+
+func (p *pcskCreateParams) String() string {
+	return fmt.Sprintf("name: %s, region: %s", p.Name, p.Region)
+}
+
+type pcskResponse struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	PublicKey   string   `json:"publicKey"`
+	FingerPrint string   `json:"fingerPrint"`
+	Regions     []string `json:"regions"`
+}
+
+func (p *pcskResponse) String() string {
+	return fmt.Sprintf("Id: %s, Name: %s, FingerPrint: %s, Regions: %v", p.Id, p.Name, p.FingerPrint, p.Regions)
+}
+
+func resourcePublicCloudSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	params := &pcskCreateParams{
+		Name:      d.Get("name").(string),
+		PublicKey: strings.TrimSpace(d.Get("public_key").(string)),
+		Region:    d.Get("region").(string),
+	}
+
+	r := &pcskResponse{}
+
+	log.Printf("[DEBUG] Will create public cloud ssh key: %s", params)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey", projectId)
+
+	err := config.OVHClient.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Created SSH Key %s", r)
+
+	//set id
+	d.SetId(r.Id)
+
+	return resourcePublicCloudSshKeyRead(d, meta)
+}
+
+func resourcePublicCloudSshKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	r := &pcskResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey/%s", projectId, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] SSH Key %s not found in project %s, removing from state", d.Id(), projectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	readPcsk(d, r)
+
+	log.Printf("[DEBUG] Read Public Cloud SSH Key %s", r)
+	return nil
+}
+
+func readPcsk(d *schema.ResourceData, r *pcskResponse) {
+	d.Set("name", r.Name)
+	d.Set("public_key", strings.TrimSpace(r.PublicKey))
+	d.Set("fingerprint", r.FingerPrint)
+	d.Set("regions", r.Regions)
+
+	d.SetId(r.Id)
+}
+
+func resourcePublicCloudSshKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Will delete public cloud ssh key for project: %s, id: %s", projectId, d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey/%s", projectId, d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Deleted Public Cloud SSH Key %s from project %s", d.Id(), projectId)
+
+	d.SetId("")
+
+	return nil
+}
+
+func pcskExists(projectId, id string, c *ovh.Client) error {
+	r := &pcskResponse{}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/sshkey/%s", projectId, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read ssh key: %s", r)
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudSshKeyConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_sshkey" "key" {
+  project_id = "%s"
+  name       = "terraform_testacc_key"
+  public_key = "%s"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"), testSSHPublicKey)
+
+var testAccPublicCloudSshKeyConfig_region = fmt.Sprintf(`
+resource "ovh_publiccloud_sshkey" "key" {
+  project_id = "%s"
+  name       = "terraform_testacc_key"
+  public_key = "%s"
+  region     = "GRA1"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"), testSSHPublicKey)
+
+func TestAccPublicCloudSshKey_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudSshKeyPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudSshKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudSshKeyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudSshKeyExists("ovh_publiccloud_sshkey.key", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_sshkey.key", "public_key", testSSHPublicKey),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_sshkey.key", "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudSshKey_importRegion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudSshKeyPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudSshKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudSshKeyConfig_region,
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_publiccloud_sshkey.key",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["ovh_publiccloud_sshkey.key"]
+					if !ok {
+						return "", fmt.Errorf("Not found: ovh_publiccloud_sshkey.key")
+					}
+
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudSshKeyPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckPublicCloudExists(t)
+}
+
+func testAccCheckPublicCloudSshKeyExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return pcskExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckPublicCloudSshKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_publiccloud_sshkey" {
+			continue
+		}
+
+		err := pcskExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Public Cloud SSH Key %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
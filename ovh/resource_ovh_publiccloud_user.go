@@ -1,27 +1,46 @@
 package ovh
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/ovh/go-ovh/ovh"
 	"log"
-	"os"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
+var pcuID = regexp.MustCompile("^([^/]+)/([0-9]+)$")
+
 func resourcePublicCloudUser() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublicCloudUserCreate,
 		Read:   resourcePublicCloudUserRead,
+		Update: resourcePublicCloudUserUpdate,
 		Delete: resourcePublicCloudUserDelete,
 
+		CustomizeDiff: resourcePublicCloudUserCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				d.Set("project_id", os.Getenv("OVH_PROJECT_ID"))
-				resourcePublicCloudUserRegeneratePassword(d, meta)
+				params := pcuID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor user id from id %q, expected projectId/userId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.SetId(params[2])
+
+				// the password is not returned by the API and is left empty
+				// rather than regenerated, to preserve running workloads
+				if err := resourcePublicCloudUserRead(d, meta); err != nil {
+					return nil, err
+				}
+
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -38,6 +57,17 @@ func resourcePublicCloudUser() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"roles": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"password_rotation_trigger": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
 			"username": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,32 +85,69 @@ func resourcePublicCloudUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// the API picks the identity version when none is set
+			"identity_api_version": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"2.0", "3"}, false),
+			},
 			"openstack_rc": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
 				Computed: true,
 			},
+			"openrc": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"clouds_yaml": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
 
 // Params
 type pcuCreateParams struct {
-	ProjectId   string `json:"serviceName"`
-	Description string `json:"description"`
+	ProjectId   string   `json:"serviceName"`
+	Description string   `json:"description"`
+	Roles       []string `json:"roles,omitempty"`
 }
 
 func (p *pcuCreateParams) String() string {
-	return fmt.Sprintf("UserParams[projectId: %s, description:%s]", p.ProjectId, p.Description)
+	return fmt.Sprintf("UserParams[projectId: %s, description:%s, roles: %v]", p.ProjectId, p.Description, p.Roles)
+}
+
+// Params
+type pcuRolesUpdateParams struct {
+	RolesIds []string `json:"rolesIds"`
+}
+
+type pcuRole struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type pcuRolesResponse struct {
+	Roles []*pcuRole `json:"roles"`
 }
 
 type pcuResponse struct {
-	Id           int    `json:"id"`
-	Username     string `json:"username"`
-	Status       string `json:"status"`
-	Description  string `json:"description"`
-	Password     string `json:"password"`
-	CreationDate string `json:"creationDate"`
+	Id           int        `json:"id"`
+	Username     string     `json:"username"`
+	Status       string     `json:"status"`
+	Description  string     `json:"description"`
+	Password     string     `json:"password"`
+	CreationDate string     `json:"creationDate"`
+	Roles        []*pcuRole `json:"roles"`
 }
 
 func (p *pcuResponse) String() string {
@@ -91,6 +158,22 @@ type pcuOpenstackRC struct {
 	Content string `json:"content"`
 }
 
+// Rotating the password also changes the attributes derived from it,
+// which are only known once the new password has been generated.
+func resourcePublicCloudUserCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("password_rotation_trigger") {
+		return nil
+	}
+
+	for _, k := range []string{"password", "clouds_yaml", "openstack_rc"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourcePublicCloudUserCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -98,6 +181,7 @@ func resourcePublicCloudUserCreate(d *schema.ResourceData, meta interface{}) err
 	params := &pcuCreateParams{
 		ProjectId:   projectId,
 		Description: d.Get("description").(string),
+		Roles:       pcuRolesFromSchema(d),
 	}
 
 	r := &pcuResponse{}
@@ -133,58 +217,132 @@ func resourcePublicCloudUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	readPcu(d, r, true)
 
-	openstackrc := make(map[string]string)
-	err = pcuGetOpenstackRC(projectId, d.Id(), config.OVHClient, openstackrc)
+	err = readPcuOpenstackRC(d, config.OVHClient)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Creating openstack creds for user %s: %s", d.Id(), err)
 	}
 
-	d.Set("openstack_rc", &openstackrc)
-
 	d.Partial(false)
 
 	return nil
 }
 
-var pcuOSTenantName = regexp.MustCompile("export OS_TENANT_NAME=\"?([[:alnum:]]+)\"?")
-var pcuOSTenantId = regexp.MustCompile("export OS_TENANT_ID=\"??([[:alnum:]]+)\"??")
-var pcuOSAuthURL = regexp.MustCompile("export OS_AUTH_URL=\"??([[:^space:]]+)\"??")
-var pcuOSUsername = regexp.MustCompile("export OS_USERNAME=\"?([[:alnum:]]+)\"?")
+var pcuOSExport = regexp.MustCompile("(?m)^[[:blank:]]*export[[:blank:]]+(OS_[[:alnum:]_]+)=(.*)$")
+var pcuOSDefault = regexp.MustCompile("^\\$\\{[[:alnum:]_]+:-(.*)\\}$")
+var pcuOSRegionLine = regexp.MustCompile("(?m)^.*\\bOS_REGION_NAME\\b.*\n?")
 
-func pcuGetOpenstackRC(projectId, id string, c *ovh.Client, rc map[string]string) error {
-	log.Printf("[DEBUG] Will read public cloud user openstack rc for project: %s, id: %s", projectId, id)
+// Region requested from the API when the user has no region, as it is
+// mandatory. It is stripped from the openstack rc.
+const pcuOSPlaceholderRegion = "to_be_overriden"
 
-	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/openrc?region=to_be_overriden", projectId, id)
+// Mandatory variables of the openstack rc
+var pcuOSRequiredVars = []string{"OS_AUTH_URL", "OS_TENANT_ID", "OS_TENANT_NAME", "OS_USERNAME"}
+
+func pcuGetOpenstackRC(projectId, id, region, version string, c *ovh.Client, rc map[string]string) (string, error) {
+	log.Printf("[DEBUG] Will read public cloud user openstack rc for project: %s, id: %s, region: %s, version: %s", projectId, id, region, version)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/openrc?region=%s", projectId, id, region)
+	if region == "" {
+		endpoint = fmt.Sprintf("/cloud/project/%s/user/%s/openrc?region=%s", projectId, id, pcuOSPlaceholderRegion)
+	}
+	if version != "" {
+		endpoint = fmt.Sprintf("%s&version=v%s", endpoint, version)
+	}
 
 	r := &pcuOpenstackRC{}
 
 	err := c.Get(endpoint, r)
 	if err != nil {
-		return fmt.Errorf("[ERROR] calling Get %s:\n\t %q", endpoint, err)
+		return "", fmt.Errorf("[ERROR] calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	content := r.Content
+	if region == "" {
+		content = pcuStripRegion(content)
+	}
+
+	err = pcuParseOpenstackRC(content, rc)
+	if err != nil {
+		return "", err
+	}
+
+	return content, nil
+}
+
+// pcuStripRegion removes the lines setting the region from an openrc script.
+func pcuStripRegion(content string) string {
+	return pcuOSRegionLine.ReplaceAllString(content, "")
+}
+
+// pcuParseOpenstackRC extracts the exported OS_* variables of an openrc
+// script. Variables defaulting to another variable are resolved to their
+// default value, and variables referencing prompted values are skipped.
+func pcuParseOpenstackRC(content string, rc map[string]string) error {
+	for _, m := range pcuOSExport.FindAllStringSubmatch(content, -1) {
+		value := strings.TrimSpace(m[2])
+		if d := pcuOSDefault.FindStringSubmatch(value); d != nil {
+			value = d[1]
+		}
+		value = strings.Trim(value, "\"'")
+
+		if value == "" || strings.HasPrefix(value, "$") {
+			continue
+		}
+		rc[m[1]] = value
 	}
 
-	authURL := pcuOSAuthURL.FindStringSubmatch(r.Content)
-	if authURL == nil {
-		return fmt.Errorf("[ERROR] couln't extract OS_AUTH_URL from content: \n\t%s", r.Content)
+	for _, v := range pcuOSRequiredVars {
+		if _, ok := rc[v]; !ok {
+			return fmt.Errorf("[ERROR] couln't extract %s from content: \n\t%s", v, content)
+		}
+	}
+
+	return nil
+}
+
+// pcuCloudsYAML renders a clouds.yaml document from the openstack rc.
+func pcuCloudsYAML(rc map[string]string, password string) string {
+	var b bytes.Buffer
+
+	b.WriteString("clouds:\n")
+	b.WriteString("  openstack:\n")
+	b.WriteString("    auth:\n")
+	fmt.Fprintf(&b, "      auth_url: %q\n", rc["OS_AUTH_URL"])
+	fmt.Fprintf(&b, "      username: %q\n", rc["OS_USERNAME"])
+	if password != "" {
+		fmt.Fprintf(&b, "      password: %q\n", password)
 	}
-	tenantName := pcuOSTenantName.FindStringSubmatch(r.Content)
-	if tenantName == nil {
-		return fmt.Errorf("[ERROR] couln't extract OS_TENANT_NAME from content: \n\t%s", r.Content)
+	fmt.Fprintf(&b, "      project_id: %q\n", rc["OS_TENANT_ID"])
+	fmt.Fprintf(&b, "      project_name: %q\n", rc["OS_TENANT_NAME"])
+	if v, ok := rc["OS_USER_DOMAIN_NAME"]; ok {
+		fmt.Fprintf(&b, "      user_domain_name: %q\n", v)
 	}
-	tenantId := pcuOSTenantId.FindStringSubmatch(r.Content)
-	if tenantId == nil {
-		return fmt.Errorf("[ERROR] couln't extract OS_TENANT_ID from content: \n\t%s", r.Content)
+	if v, ok := rc["OS_PROJECT_DOMAIN_NAME"]; ok {
+		fmt.Fprintf(&b, "      project_domain_name: %q\n", v)
 	}
-	username := pcuOSUsername.FindStringSubmatch(r.Content)
-	if username == nil {
-		return fmt.Errorf("[ERROR] couln't extract OS_USERNAME from content: \n\t%s", r.Content)
+	if v, ok := rc["OS_REGION_NAME"]; ok {
+		fmt.Fprintf(&b, "    region_name: %q\n", v)
+	}
+	b.WriteString("    interface: \"public\"\n")
+	if v, ok := rc["OS_IDENTITY_API_VERSION"]; ok {
+		fmt.Fprintf(&b, "    identity_api_version: %s\n", v)
 	}
 
-	rc["OS_AUTH_URL"] = authURL[1]
-	rc["OS_TENANT_ID"] = tenantId[1]
-	rc["OS_TENANT_NAME"] = tenantName[1]
-	rc["OS_USERNAME"] = username[1]
+	return b.String()
+}
 
+func readPcuOpenstackRC(d *schema.ResourceData, c *ovh.Client) error {
+	version := d.Get("identity_api_version").(string)
+
+	openstackrc := make(map[string]string)
+	content, err := pcuGetOpenstackRC(d.Get("project_id").(string), d.Id(), d.Get("region").(string), version, c, openstackrc)
+	if err != nil {
+		return err
+	}
+
+	d.Set("openstack_rc", &openstackrc)
+	d.Set("openrc", content)
+	d.Set("clouds_yaml", pcuCloudsYAML(openstackrc, d.Get("password").(string)))
 	return nil
 }
 
@@ -207,13 +365,10 @@ func resourcePublicCloudUserRead(d *schema.ResourceData, meta interface{}) error
 
 	readPcu(d, r, false)
 
-	openstackrc := make(map[string]string)
-	err = pcuGetOpenstackRC(projectId, d.Id(), config.OVHClient, openstackrc)
+	err = readPcuOpenstackRC(d, config.OVHClient)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Reading openstack creds for user %s: %s", d.Id(), err)
 	}
-
-	d.Set("openstack_rc", &openstackrc)
 	d.Partial(false)
 	log.Printf("[DEBUG] Read Public Cloud User %s", r)
 	return nil
@@ -226,10 +381,9 @@ func resourcePublicCloudUserRegeneratePassword(d *schema.ResourceData, meta inte
 
 	r := &pcuResponse{}
 
-	// Every time you read the user, we must regenerate a password
-	// to be able to set it as an attribute because the password
-	// is not returned by the GET method
-	// thus, update the user
+	// The password is not returned by the GET method, thus it is
+	// only known when the user is created or when it is regenerated
+	// because password_rotation_trigger has changed
 	log.Printf("[DEBUG] Will read & regenerate password for public cloud user %s from project: %s", d.Id(), projectId)
 
 	d.Partial(true)
@@ -259,19 +413,94 @@ func resourcePublicCloudUserRegeneratePassword(d *schema.ResourceData, meta inte
 
 	readPcu(d, r, true)
 
-	openstackrc := make(map[string]string)
-	err = pcuGetOpenstackRC(projectId, d.Id(), config.OVHClient, openstackrc)
+	err = readPcuOpenstackRC(d, config.OVHClient)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Reading openstack creds for user %s: %s", d.Id(), err)
 	}
-
-	d.Set("openstack_rc", &openstackrc)
 	d.Partial(false)
 	log.Printf("[DEBUG] Read Public Cloud User %s", r)
 	return nil
 }
 
+func resourcePublicCloudUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	if d.HasChange("roles") {
+		roles, err := pcuRolesIds(config.OVHClient, projectId, pcuRolesFromSchema(d))
+		if err != nil {
+			return err
+		}
+
+		params := &pcuRolesUpdateParams{RolesIds: roles}
+
+		log.Printf("[DEBUG] Will update roles of public cloud user %s from project %s: %v", d.Id(), projectId, params.RolesIds)
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/role", projectId, d.Id())
+
+		err = config.OVHClient.Put(endpoint, params, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] calling Put %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		log.Printf("[DEBUG] Updated roles of Public Cloud User %s", d.Id())
+	}
+
+	if d.HasChange("password_rotation_trigger") {
+		if err := resourcePublicCloudUserRegeneratePassword(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourcePublicCloudUserRead(d, meta)
+}
+
+func pcuRolesFromSchema(d *schema.ResourceData) []string {
+	roles := make([]string, 0)
+	for _, v := range d.Get("roles").(*schema.Set).List() {
+		roles = append(roles, v.(string))
+	}
+	return roles
+}
+
+// pcuRolesIds converts role names to the role ids of a project
+func pcuRolesIds(c *ovh.Client, projectId string, names []string) ([]string, error) {
+	r := &pcuRolesResponse{}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/role", projectId)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	ids := make([]string, 0)
+	for _, name := range names {
+		found := false
+		for _, role := range r.Roles {
+			if role.Name == name {
+				ids = append(ids, role.Id)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("[ERROR] unknown role %q for project %s", name, projectId)
+		}
+	}
+
+	return ids, nil
+}
+
 func readPcu(d *schema.ResourceData, r *pcuResponse, setPassword bool) {
+	roles := make([]string, 0)
+	for _, role := range r.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	d.Set("roles", roles)
 	d.Set("description", r.Description)
 	d.Set("status", r.Status)
 	d.Set("creation_date", r.CreationDate)
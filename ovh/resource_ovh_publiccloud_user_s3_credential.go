@@ -0,0 +1,190 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+)
+
+var pcus3cID = regexp.MustCompile("^([^/]+)/([0-9]+)/([^/]+)$")
+
+func resourcePublicCloudUserS3Credential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudUserS3CredentialCreate,
+		Read:   resourcePublicCloudUserS3CredentialRead,
+		Delete: resourcePublicCloudUserS3CredentialDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcus3cID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id, user id nor access key from id %q, expected projectId/userId/accessKey", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.Set("user_id", params[2])
+				d.SetId(params[3])
+
+				if err := resourcePublicCloudUserS3CredentialRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_access_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type pcus3cResponse struct {
+	Access   string `json:"access"`
+	Secret   string `json:"secret"`
+	UserId   string `json:"userId"`
+	TenantId string `json:"tenantId"`
+}
+
+func (p *pcus3cResponse) String() string {
+	return fmt.Sprintf("S3CredentialResponse[Access: %s, UserId: %s, TenantId: %s]", p.Access, p.UserId, p.TenantId)
+}
+
+type pcus3cSecretResponse struct {
+	Secret string `json:"secret"`
+}
+
+func resourcePublicCloudUserS3CredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	userId := d.Get("user_id").(string)
+
+	r := &pcus3cResponse{}
+
+	log.Printf("[DEBUG] Will create s3 credential for public cloud user %s from project: %s", userId, projectId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials", projectId, userId)
+
+	err := config.OVHClient.Post(endpoint, nil, r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Created S3 Credential %s", r)
+
+	//set id
+	d.SetId(r.Access)
+	d.Set("secret_access_key", r.Secret)
+
+	return resourcePublicCloudUserS3CredentialRead(d, meta)
+}
+
+func resourcePublicCloudUserS3CredentialRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	userId := d.Get("user_id").(string)
+
+	r := &pcus3cResponse{}
+
+	log.Printf("[DEBUG] Will read s3 credential %s of public cloud user %s from project: %s", d.Id(), userId, projectId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials/%s", projectId, userId, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] S3 Credential %s of user %s not found, removing from state", d.Id(), userId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	// the secret is only returned on creation, retrieve it on import
+	if d.Get("secret_access_key").(string) == "" {
+		s := &pcus3cSecretResponse{}
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials/%s/secret", projectId, userId, d.Id())
+
+		err := config.OVHClient.Post(endpoint, nil, s)
+		if err != nil {
+			return fmt.Errorf("[ERROR] calling Post %s:\n\t %q", endpoint, err)
+		}
+
+		d.Set("secret_access_key", s.Secret)
+	}
+
+	d.Set("access_key_id", r.Access)
+	d.Set("tenant_id", r.TenantId)
+
+	log.Printf("[DEBUG] Read S3 Credential %s", r)
+	return nil
+}
+
+func resourcePublicCloudUserS3CredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	userId := d.Get("user_id").(string)
+	id := d.Id()
+
+	log.Printf("[DEBUG] Will delete s3 credential %s of public cloud user %s from project: %s", id, userId, projectId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials/%s", projectId, userId, id)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); !ok || apiErr.Code != 404 {
+			return fmt.Errorf("[ERROR] calling Delete %s:\n\t %q", endpoint, err)
+		}
+		log.Printf("[DEBUG] S3 Credential %s of user %s already deleted", id, userId)
+	}
+
+	d.SetId("")
+
+	log.Printf("[DEBUG] Deleted S3 Credential %s of Public Cloud User %s from project %s", id, userId, projectId)
+	return nil
+}
+
+func pcus3cExists(projectId, userId, id string, c *ovh.Client) error {
+	r := &pcus3cResponse{}
+
+	log.Printf("[DEBUG] Will read s3 credential %s of public cloud user %s from project: %s", id, userId, projectId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/s3Credentials/%s", projectId, userId, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling Get %s:\n\t %q", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read s3 credential: %s", r)
+
+	return nil
+}
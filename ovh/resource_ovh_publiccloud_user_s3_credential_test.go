@@ -0,0 +1,94 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudUserS3CredentialConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_user" "user" {
+	project_id  = "%s"
+  description = "my user for acceptance tests"
+  roles       = ["objectstore_operator"]
+}
+
+resource "ovh_publiccloud_user_s3_credential" "creds" {
+	project_id = "${ovh_publiccloud_user.user.project_id}"
+  user_id    = "${ovh_publiccloud_user.user.id}"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudUserS3Credential_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudUserPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudUserS3CredentialDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudUserS3CredentialConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudUserS3CredentialExists("ovh_publiccloud_user_s3_credential.creds", t),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_user_s3_credential.creds", "access_key_id"),
+					resource.TestCheckResourceAttrSet("ovh_publiccloud_user_s3_credential.creds", "secret_access_key"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_publiccloud_user_s3_credential.creds",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPublicCloudUserS3CredentialImportId("ovh_publiccloud_user_s3_credential.creds"),
+			},
+		},
+	})
+}
+
+func testAccPublicCloudUserS3CredentialImportId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckPublicCloudUserS3CredentialExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		if rs.Primary.Attributes["user_id"] == "" {
+			return fmt.Errorf("No User ID is set")
+		}
+
+		return pcus3cExists(rs.Primary.Attributes["project_id"], rs.Primary.Attributes["user_id"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckPublicCloudUserS3CredentialDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_publiccloud_user_s3_credential" {
+			continue
+		}
+
+		err := pcus3cExists(rs.Primary.Attributes["project_id"], rs.Primary.Attributes["user_id"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Public Cloud User S3 Credential still exists")
+		}
+
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+)
+
+// pcus3pEmptyPolicy is the policy applied when the resource is destroyed
+const pcus3pEmptyPolicy = `{"Statement":[]}`
+
+func resourcePublicCloudUserS3Policy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudUserS3PolicyCreate,
+		Read:   resourcePublicCloudUserS3PolicyRead,
+		Update: resourcePublicCloudUserS3PolicyCreate,
+		Delete: resourcePublicCloudUserS3PolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcuID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor user id from id %q, expected projectId/userId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.Set("user_id", params[2])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudUserS3PolicyRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateS3Policy,
+				StateFunc: func(v interface{}) string {
+					policy, _ := normalizeJSON(v.(string))
+					return policy
+				},
+			},
+		},
+	}
+}
+
+// Params
+type pcus3pParams struct {
+	Policy string `json:"policy"`
+}
+
+func resourcePublicCloudUserS3PolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	userId := d.Get("user_id").(string)
+
+	policy, err := normalizeJSON(d.Get("policy").(string))
+	if err != nil {
+		return fmt.Errorf("[ERROR] invalid policy document: %s", err)
+	}
+
+	err = pcus3pImport(config.OVHClient, projectId, userId, policy)
+	if err != nil {
+		return err
+	}
+
+	//set id
+	d.SetId(userId)
+
+	return resourcePublicCloudUserS3PolicyRead(d, meta)
+}
+
+func resourcePublicCloudUserS3PolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	userId := d.Get("user_id").(string)
+
+	r := &pcus3pParams{}
+
+	log.Printf("[DEBUG] Will read s3 policy of public cloud user %s from project: %s", userId, projectId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/policy", projectId, userId)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] User %s not found, removing s3 policy from state", userId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	policy, err := normalizeJSON(r.Policy)
+	if err != nil {
+		return fmt.Errorf("[ERROR] invalid policy document returned for user %s: %s", userId, err)
+	}
+
+	d.Set("policy", policy)
+
+	log.Printf("[DEBUG] Read S3 Policy of Public Cloud User %s: %s", userId, policy)
+	return nil
+}
+
+func resourcePublicCloudUserS3PolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	userId := d.Get("user_id").(string)
+
+	err := pcus3pImport(config.OVHClient, projectId, userId, pcus3pEmptyPolicy)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	log.Printf("[DEBUG] Deleted S3 Policy of Public Cloud User %s from project %s", userId, projectId)
+	return nil
+}
+
+func pcus3pImport(c *ovh.Client, projectId, userId, policy string) error {
+	params := &pcus3pParams{Policy: policy}
+
+	log.Printf("[DEBUG] Will import s3 policy for public cloud user %s from project %s: %s", userId, projectId, policy)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/policy", projectId, userId)
+
+	err := c.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling Post %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return nil
+}
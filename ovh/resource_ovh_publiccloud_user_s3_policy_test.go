@@ -0,0 +1,82 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudUserS3PolicyConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_user" "user" {
+	project_id  = "%s"
+  description = "my user for acceptance tests"
+  roles       = ["objectstore_operator"]
+}
+
+resource "ovh_publiccloud_user_s3_policy" "policy" {
+	project_id = "${ovh_publiccloud_user.user.project_id}"
+  user_id    = "${ovh_publiccloud_user.user.id}"
+  policy     = <<EOF
+{
+  "Statement": [
+    {
+      "Sid": "ReadOnly",
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:ListBucket"],
+      "Resource": ["arn:aws:s3:::terraform-testacc", "arn:aws:s3:::terraform-testacc/*"]
+    }
+  ]
+}
+EOF
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudUserS3Policy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudUserPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudUserS3PolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudUserS3PolicyExists("ovh_publiccloud_user_s3_policy.policy", t),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudUserS3PolicyExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["user_id"] == "" {
+			return fmt.Errorf("No User ID is set")
+		}
+
+		r := &pcus3pParams{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/user/%s/policy", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["user_id"])
+		if err := config.OVHClient.Get(endpoint, r); err != nil {
+			return fmt.Errorf("[ERROR] calling Get %s:\n\t %q", endpoint, err)
+		}
+
+		policy, err := normalizeJSON(r.Policy)
+		if err != nil {
+			return err
+		}
+
+		if policy != rs.Primary.Attributes["policy"] {
+			return fmt.Errorf("Policy %s differs from state %s", policy, rs.Primary.Attributes["policy"])
+		}
+
+		return nil
+	}
+}
@@ -5,9 +5,31 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"os"
+	"strings"
 	"testing"
 )
 
+const testPublicCloudUserOpenRC = `#!/bin/bash
+
+export OS_AUTH_URL=https://auth.cloud.ovh.net/v3/
+export OS_IDENTITY_API_VERSION=3
+
+export OS_USER_DOMAIN_NAME=${OS_USER_DOMAIN_NAME:-"Default"}
+export OS_PROJECT_DOMAIN_NAME=${OS_PROJECT_DOMAIN_NAME:-"Default"}
+
+export OS_TENANT_ID=0123456789abcdef0123456789abcdef
+export OS_TENANT_NAME="1234567890123456"
+
+export OS_USERNAME="abcDEF123"
+
+echo "Please enter your OpenStack Password: "
+read -sr OS_PASSWORD_INPUT
+export OS_PASSWORD=$OS_PASSWORD_INPUT
+
+export OS_REGION_NAME="GRA1"
+if [ -z "$OS_REGION_NAME" ]; then unset OS_REGION_NAME; fi
+`
+
 var testAccPublicCloudUserConfig = fmt.Sprintf(`
 resource "ovh_publiccloud_user" "user" {
 	project_id  = "%s"
@@ -15,6 +37,25 @@ resource "ovh_publiccloud_user" "user" {
 }
 `, os.Getenv("OVH_PUBLIC_CLOUD"))
 
+var testAccPublicCloudUserConfig_roles = `
+resource "ovh_publiccloud_user" "user" {
+	project_id  = "%s"
+  description = "my user for acceptance tests"
+  roles       = [%s]
+}
+`
+
+var testAccPublicCloudUserConfig_rotation = `
+resource "ovh_publiccloud_user" "user" {
+	project_id  = "%s"
+  description = "my user for acceptance tests"
+
+  password_rotation_trigger {
+    rotation = "%s"
+  }
+}
+`
+
 func TestAccPublicCloudUser_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccCheckPublicCloudUserPreCheck(t) },
@@ -32,6 +73,187 @@ func TestAccPublicCloudUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccPublicCloudUser_roles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudUserPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudUserConfig_roles, os.Getenv("OVH_PUBLIC_CLOUD"), `"compute_operator"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudUserExists("ovh_publiccloud_user.user", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_user.user", "roles.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudUserConfig_roles, os.Getenv("OVH_PUBLIC_CLOUD"), `"compute_operator", "network_operator"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudUserExists("ovh_publiccloud_user.user", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_user.user", "roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudUser_passwordRotation(t *testing.T) {
+	var password string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudUserPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudUserConfig_rotation, os.Getenv("OVH_PUBLIC_CLOUD"), "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudUserExists("ovh_publiccloud_user.user", t),
+					testAccCheckPublicCloudUserPassword("ovh_publiccloud_user.user", &password, false),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudUserConfig_rotation, os.Getenv("OVH_PUBLIC_CLOUD"), "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudUserExists("ovh_publiccloud_user.user", t),
+					testAccCheckPublicCloudUserPassword("ovh_publiccloud_user.user", &password, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPublicCloudUser_importBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudUserPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudUserConfig,
+			},
+			resource.TestStep{
+				ResourceName:            "ovh_publiccloud_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "clouds_yaml"},
+				ImportStateIdFunc:       testAccPublicCloudUserImportId("ovh_publiccloud_user.user"),
+			},
+		},
+	})
+}
+
+func testAccPublicCloudUserImportId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+	}
+}
+
+// testAccCheckPublicCloudUserPassword stores the user password and checks
+// whether it has been rotated since the previous check
+func testAccCheckPublicCloudUserPassword(n string, password *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["password"]
+		if current == "" {
+			return fmt.Errorf("No password is set")
+		}
+
+		if rotated && current == *password {
+			return fmt.Errorf("Password has not been rotated")
+		}
+
+		*password = current
+		return nil
+	}
+}
+
+func TestPublicCloudUserParseOpenstackRC(t *testing.T) {
+	rc := make(map[string]string)
+	if err := pcuParseOpenstackRC(testPublicCloudUserOpenRC, rc); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]string{
+		"OS_AUTH_URL":             "https://auth.cloud.ovh.net/v3/",
+		"OS_IDENTITY_API_VERSION": "3",
+		"OS_USER_DOMAIN_NAME":     "Default",
+		"OS_PROJECT_DOMAIN_NAME":  "Default",
+		"OS_TENANT_ID":            "0123456789abcdef0123456789abcdef",
+		"OS_TENANT_NAME":          "1234567890123456",
+		"OS_USERNAME":             "abcDEF123",
+		"OS_REGION_NAME":          "GRA1",
+	}
+
+	if len(rc) != len(expected) {
+		t.Fatalf("expected %d variables, got %d: %v", len(expected), len(rc), rc)
+	}
+	for k, v := range expected {
+		if rc[k] != v {
+			t.Fatalf("expected %s to be %q, got %q", k, v, rc[k])
+		}
+	}
+
+	if err := pcuParseOpenstackRC("export OS_AUTH_URL=https://auth.cloud.ovh.net/v3/", rc); err != nil {
+		t.Fatalf("previously extracted variables should be kept: %s", err)
+	}
+	if err := pcuParseOpenstackRC("export OS_AUTH_URL=https://auth.cloud.ovh.net/v3/", make(map[string]string)); err == nil {
+		t.Fatalf("missing variables should be an error")
+	}
+}
+
+func TestPublicCloudUserCloudsYAML(t *testing.T) {
+	rc := make(map[string]string)
+	if err := pcuParseOpenstackRC(testPublicCloudUserOpenRC, rc); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	yaml := pcuCloudsYAML(rc, "secret")
+	for _, line := range []string{
+		`      auth_url: "https://auth.cloud.ovh.net/v3/"`,
+		`      password: "secret"`,
+		`      user_domain_name: "Default"`,
+		`    region_name: "GRA1"`,
+		`    identity_api_version: 3`,
+	} {
+		if !strings.Contains(yaml, line+"\n") {
+			t.Fatalf("expected clouds.yaml to contain %q, got:\n%s", line, yaml)
+		}
+	}
+
+	if strings.Contains(pcuCloudsYAML(rc, ""), "password") {
+		t.Fatalf("clouds.yaml shouldn't contain an empty password")
+	}
+}
+
+func TestPublicCloudUserStripRegion(t *testing.T) {
+	content := pcuStripRegion(testPublicCloudUserOpenRC)
+	if strings.Contains(content, "OS_REGION_NAME") {
+		t.Fatalf("expected the region to be stripped, got:\n%s", content)
+	}
+
+	rc := make(map[string]string)
+	if err := pcuParseOpenstackRC(content, rc); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, ok := rc["OS_REGION_NAME"]; ok {
+		t.Fatalf("expected no OS_REGION_NAME, got %v", rc)
+	}
+
+	if strings.Contains(pcuCloudsYAML(rc, "secret"), "region_name") {
+		t.Fatalf("clouds.yaml shouldn't contain a region when none is set")
+	}
+}
+
 func testAccCheckPublicCloudUserPreCheck(t *testing.T) {
 	testAccPreCheck(t)
 	testAccCheckPublicCloudExists(t)
@@ -85,6 +307,14 @@ func testAccCheckPublicCloudUserOpenRC(n string, t *testing.T) resource.TestChec
 			return fmt.Errorf("No openstack_rc.OS_USERNAME is set")
 		}
 
+		if rs.Primary.Attributes["openrc"] == "" {
+			return fmt.Errorf("No openrc is set")
+		}
+
+		if rs.Primary.Attributes["clouds_yaml"] == "" {
+			return fmt.Errorf("No clouds_yaml is set")
+		}
+
 		return nil
 	}
 }
@@ -0,0 +1,413 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+	"time"
+)
+
+var pcvID = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
+func resourcePublicCloudVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudVolumeCreate,
+		Read:   resourcePublicCloudVolumeRead,
+		Update: resourcePublicCloudVolumeUpdate,
+		Delete: resourcePublicCloudVolumeDelete,
+
+		CustomizeDiff: resourcePublicCloudVolumeCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcvID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor volume id from id %q, expected projectId/volumeId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudVolumeRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "classic",
+				ValidateFunc: validation.StringInSlice([]string{"classic", "high-speed"}, false),
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"bootable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"attached_to": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Params
+type pcvCreateParams struct {
+	ProjectId   string `json:"serviceName"`
+	Region      string `json:"region"`
+	Size        int    `json:"size"`
+	Type        string `json:"type"`
+	ImageId     string `json:"imageId,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (p *pcvCreateParams) String() string {
+	return fmt.Sprintf("projectId: %s, region: %s, size: %d, type: %s, imageId: %s, name: %s", p.ProjectId, p.Region, p.Size, p.Type, p.ImageId, p.Name)
+}
+
+// Params
+type pcvUpdateParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Params
+type pcvUpsizeParams struct {
+	Size int `json:"size"`
+}
+
+type pcvResponse struct {
+	Id           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Region       string   `json:"region"`
+	Size         int      `json:"size"`
+	Type         string   `json:"type"`
+	ImageId      string   `json:"imageId"`
+	Bootable     bool     `json:"bootable"`
+	AttachedTo   []string `json:"attachedTo"`
+	Status       string   `json:"status"`
+	CreationDate string   `json:"creationDate"`
+}
+
+func (p *pcvResponse) String() string {
+	return fmt.Sprintf("Id: %s, Name: %s, Region: %s, Size: %d, Type: %s, Status: %s, AttachedTo: %v", p.Id, p.Name, p.Region, p.Size, p.Type, p.Status, p.AttachedTo)
+}
+
+// Volumes can only be grown in place, shrinking one recreates it.
+func resourcePublicCloudVolumeCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("size") {
+		return nil
+	}
+
+	o, n := d.GetChange("size")
+	if n.(int) < o.(int) {
+		return d.ForceNew("size")
+	}
+
+	return nil
+}
+
+func resourcePublicCloudVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	params := &pcvCreateParams{
+		ProjectId:   d.Get("project_id").(string),
+		Region:      d.Get("region").(string),
+		Size:        d.Get("size").(int),
+		Type:        d.Get("type").(string),
+		ImageId:     d.Get("image_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	r, err := pcvCreate(config.OVHClient, params)
+	if err != nil {
+		return err
+	}
+
+	//set id
+	d.SetId(r.Id)
+
+	return resourcePublicCloudVolumeRead(d, meta)
+}
+
+// pcvCreate creates a volume and waits for it to be available.
+func pcvCreate(c *ovh.Client, params *pcvCreateParams) (*pcvResponse, error) {
+	r := &pcvResponse{}
+
+	log.Printf("[DEBUG] Will create public cloud volume: %s", params)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume", params.ProjectId)
+
+	err := c.Post(endpoint, params, r)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Volume %s:", r)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "downloading"},
+		Target:     []string{"available"},
+		Refresh:    pcvRefreshFunc(c, params.ProjectId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] waiting for volume (%s): %s", params, err)
+	}
+	log.Printf("[DEBUG] Created Volume %s", r)
+
+	return r, nil
+}
+
+func resourcePublicCloudVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	r := &pcvResponse{}
+
+	log.Printf("[DEBUG] Will read public cloud volume for project: %s, id: %s", projectId, d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s", projectId, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Volume %s not found in project %s, removing from state", d.Id(), projectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("region", r.Region)
+	d.Set("size", r.Size)
+	d.Set("type", r.Type)
+	d.Set("image_id", r.ImageId)
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("bootable", r.Bootable)
+	d.Set("attached_to", r.AttachedTo)
+	d.Set("status", r.Status)
+	d.Set("creation_date", r.CreationDate)
+
+	log.Printf("[DEBUG] Read Public Cloud Volume %s", r)
+	return nil
+}
+
+func resourcePublicCloudVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		params := &pcvUpdateParams{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+
+		log.Printf("[DEBUG] Will update public cloud volume %s: %v", d.Id(), params)
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s", projectId, d.Id())
+
+		err := config.OVHClient.Put(endpoint, params, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+		}
+	}
+
+	if d.HasChange("size") {
+		params := &pcvUpsizeParams{
+			Size: d.Get("size").(int),
+		}
+
+		log.Printf("[DEBUG] Will upsize public cloud volume %s to %d", d.Id(), params.Size)
+
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s/upsize", projectId, d.Id())
+
+		err := config.OVHClient.Post(endpoint, params, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+		}
+
+		// attached volumes are resized online and go back to in-use
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"extending", "resizing"},
+			Target:     []string{"available", "in-use"},
+			Refresh:    pcvUpsizeRefreshFunc(config.OVHClient, projectId, d.Id(), params.Size),
+			Timeout:    10 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("[ERROR] waiting for volume %s to be upsized: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePublicCloudVolumeRead(d, meta)
+}
+
+func resourcePublicCloudVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	id := d.Id()
+
+	log.Printf("[DEBUG] Will delete public cloud volume for project: %s, id: %s", projectId, id)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s", projectId, id)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    pcvDeleteRefreshFunc(config.OVHClient, projectId, id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] deleting volume %s from project %s: %s", id, projectId, err)
+	}
+	log.Printf("[DEBUG] Deleted Public Cloud Volume %s from project %s", id, projectId)
+
+	d.SetId("")
+
+	return nil
+}
+
+func pcvExists(projectId, id string, c *ovh.Client) error {
+	r := &pcvResponse{}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s", projectId, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read volume: %s", r)
+
+	return nil
+}
+
+// VolumeRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud volume.
+func pcvRefreshFunc(c *ovh.Client, projectId, pcvId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := &pcvResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s", projectId, pcvId)
+		err := c.Get(endpoint, r)
+		if err != nil {
+			return r, "", err
+		}
+
+		log.Printf("[DEBUG] Pending Volume: %s", r)
+		return r, r.Status, nil
+	}
+}
+
+// VolumeUpsizeRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud volume being upsized. The upsize request returns before
+// the volume status changes, so the volume is considered extending until it
+// reports its new size.
+func pcvUpsizeRefreshFunc(c *ovh.Client, projectId, pcvId string, size int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r, status, err := pcvRefreshFunc(c, projectId, pcvId)()
+		if err != nil {
+			return r, status, err
+		}
+
+		if v := r.(*pcvResponse); v.Size != size {
+			log.Printf("[DEBUG] Volume %s is %dGB, waiting for %dGB", pcvId, v.Size, size)
+			return r, "extending", nil
+		}
+
+		return r, status, nil
+	}
+}
+
+// VolumeDeleteRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud volume being deleted.
+func pcvDeleteRefreshFunc(c *ovh.Client, projectId, pcvId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := &pcvResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s", projectId, pcvId)
+		err := c.Get(endpoint, r)
+		if err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				log.Printf("[DEBUG] volume id %s on project %s deleted", pcvId, projectId)
+				return r, "deleted", nil
+			}
+			return r, "", err
+		}
+
+		log.Printf("[DEBUG] Pending Volume: %s", r)
+		return r, r.Status, nil
+	}
+}
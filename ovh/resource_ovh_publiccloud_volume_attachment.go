@@ -0,0 +1,183 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+	"time"
+)
+
+var pcvaID = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
+func resourcePublicCloudVolumeAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudVolumeAttachmentCreate,
+		Read:   resourcePublicCloudVolumeAttachmentRead,
+		Delete: resourcePublicCloudVolumeAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcvaID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor volume id from id %q, expected projectId/volumeId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.Set("volume_id", params[2])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudVolumeAttachmentRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// Params
+type pcvaParams struct {
+	InstanceId string `json:"instanceId"`
+}
+
+// A volume can only be attached to a single instance,
+// so the attachment is identified by the volume id.
+func resourcePublicCloudVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	volumeId := d.Get("volume_id").(string)
+	params := &pcvaParams{InstanceId: d.Get("instance_id").(string)}
+
+	log.Printf("[DEBUG] Will attach public cloud volume %s to instance %s", volumeId, params.InstanceId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s/attach", projectId, volumeId)
+
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "attaching"},
+		Target:     []string{"in-use"},
+		Refresh:    pcvRefreshFunc(config.OVHClient, projectId, volumeId),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] waiting for volume %s to be attached to instance %s: %s", volumeId, params.InstanceId, err)
+	}
+	log.Printf("[DEBUG] Attached Volume %s to Instance %s", volumeId, params.InstanceId)
+
+	//set id
+	d.SetId(volumeId)
+
+	return resourcePublicCloudVolumeAttachmentRead(d, meta)
+}
+
+func resourcePublicCloudVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	volumeId := d.Id()
+	instanceId := d.Get("instance_id").(string)
+
+	r := &pcvResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s", projectId, volumeId)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Volume %s not found in project %s, removing attachment from state", volumeId, projectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	// on import, the instance is the one the volume is attached to
+	if instanceId == "" && len(r.AttachedTo) > 0 {
+		instanceId = r.AttachedTo[0]
+	}
+
+	attached := false
+	for _, id := range r.AttachedTo {
+		if id == instanceId {
+			attached = true
+		}
+	}
+
+	if !attached {
+		log.Printf("[WARN] Volume %s is not attached to instance %s anymore, removing from state", volumeId, instanceId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("volume_id", volumeId)
+	d.Set("instance_id", instanceId)
+
+	log.Printf("[DEBUG] Read Volume %s attached to Instance %s", volumeId, instanceId)
+	return nil
+}
+
+func resourcePublicCloudVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	volumeId := d.Id()
+	params := &pcvaParams{InstanceId: d.Get("instance_id").(string)}
+
+	log.Printf("[DEBUG] Will detach public cloud volume %s from instance %s", volumeId, params.InstanceId)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s/detach", projectId, volumeId)
+
+	err := config.OVHClient.Post(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"in-use", "detaching"},
+		Target:     []string{"available"},
+		Refresh:    pcvRefreshFunc(config.OVHClient, projectId, volumeId),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] waiting for volume %s to be detached from instance %s: %s", volumeId, params.InstanceId, err)
+	}
+	log.Printf("[DEBUG] Detached Volume %s from Instance %s", volumeId, params.InstanceId)
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudVolumeAttachmentConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_instance" "instance" {
+  project_id  = "%s"
+  name        = "terraform_testacc_instance"
+  region      = "GRA1"
+  flavor_name = "s1-2"
+  image_name  = "Ubuntu 16.04"
+}
+
+resource "ovh_publiccloud_volume" "volume" {
+  project_id = "${ovh_publiccloud_instance.instance.project_id}"
+  region     = "${ovh_publiccloud_instance.instance.region}"
+  name       = "terraform_testacc_volume"
+  size       = 10
+}
+
+resource "ovh_publiccloud_volume_attachment" "attach" {
+  project_id  = "${ovh_publiccloud_volume.volume.project_id}"
+  volume_id   = "${ovh_publiccloud_volume.volume.id}"
+  instance_id = "${ovh_publiccloud_instance.instance.id}"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudVolumeAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudVolumePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudVolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudVolumeAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ovh_publiccloud_volume_attachment.attach", "instance_id", "ovh_publiccloud_instance.instance", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,311 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+	"time"
+)
+
+var pcvsID = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
+func resourcePublicCloudVolumeSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudVolumeSnapshotCreate,
+		Read:   resourcePublicCloudVolumeSnapshotRead,
+		Update: resourcePublicCloudVolumeSnapshotUpdate,
+		Delete: resourcePublicCloudVolumeSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcvsID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id nor snapshot id from id %q, expected projectId/snapshotId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.SetId(params[2])
+
+				if err := resourcePublicCloudVolumeSnapshotRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			// retention: older snapshots of the same volume with
+			// the same name are deleted beyond the keep_last newest
+			"keep_last": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Params
+type pcvsCreateParams struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type pcvsResponse struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	VolumeId     string `json:"volumeId"`
+	Region       string `json:"region"`
+	Size         int    `json:"size"`
+	Status       string `json:"status"`
+	CreationDate string `json:"creationDate"`
+}
+
+func (p *pcvsResponse) String() string {
+	return fmt.Sprintf("Id: %s, Name: %s, VolumeId: %s, Region: %s, Size: %d, Status: %s", p.Id, p.Name, p.VolumeId, p.Region, p.Size, p.Status)
+}
+
+func resourcePublicCloudVolumeSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	volumeId := d.Get("volume_id").(string)
+	params := &pcvsCreateParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	r := &pcvsResponse{}
+
+	log.Printf("[DEBUG] Will snapshot public cloud volume %s: %v", volumeId, params)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/%s/snapshot", projectId, volumeId)
+
+	err := config.OVHClient.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Volume Snapshot %s:", r)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    pcvsRefreshFunc(config.OVHClient, projectId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] waiting for snapshot of volume %s: %s", volumeId, err)
+	}
+	log.Printf("[DEBUG] Created Volume Snapshot %s", r)
+
+	//set id
+	d.SetId(r.Id)
+
+	// the snapshot exists: a failed pruning mustn't taint it
+	if err := pcvsPrune(d, config); err != nil {
+		log.Printf("[WARN] couldn't prune the snapshots of volume %s: %s", volumeId, err)
+	}
+
+	return resourcePublicCloudVolumeSnapshotRead(d, meta)
+}
+
+func resourcePublicCloudVolumeSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("keep_last") {
+		if err := pcvsPrune(d, config); err != nil {
+			return err
+		}
+	}
+
+	return resourcePublicCloudVolumeSnapshotRead(d, meta)
+}
+
+func resourcePublicCloudVolumeSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+
+	r := &pcvsResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s", projectId, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Volume Snapshot %s not found in project %s, removing from state", d.Id(), projectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("volume_id", r.VolumeId)
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("region", r.Region)
+	d.Set("size", r.Size)
+	d.Set("status", r.Status)
+	d.Set("creation_date", r.CreationDate)
+
+	log.Printf("[DEBUG] Read Public Cloud Volume Snapshot %s", r)
+	return nil
+}
+
+func resourcePublicCloudVolumeSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	id := d.Id()
+
+	log.Printf("[DEBUG] Will delete public cloud volume snapshot for project: %s, id: %s", projectId, id)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s", projectId, id)
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    pcvsDeleteRefreshFunc(config.OVHClient, projectId, id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] deleting volume snapshot %s from project %s: %s", id, projectId, err)
+	}
+	log.Printf("[DEBUG] Deleted Public Cloud Volume Snapshot %s from project %s", id, projectId)
+
+	d.SetId("")
+
+	return nil
+}
+
+func pcvsExists(projectId, id string, c *ovh.Client) error {
+	r := &pcvsResponse{}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s", projectId, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read volume snapshot: %s", r)
+
+	return nil
+}
+
+// VolumeSnapshotRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud volume snapshot.
+func pcvsRefreshFunc(c *ovh.Client, projectId, pcvsId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := &pcvsResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s", projectId, pcvsId)
+		err := c.Get(endpoint, r)
+		if err != nil {
+			return r, "", err
+		}
+
+		log.Printf("[DEBUG] Pending Volume Snapshot: %s", r)
+		return r, r.Status, nil
+	}
+}
+
+// VolumeSnapshotDeleteRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OVH public cloud volume snapshot being deleted.
+func pcvsDeleteRefreshFunc(c *ovh.Client, projectId, pcvsId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r := &pcvsResponse{}
+		endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s", projectId, pcvsId)
+		err := c.Get(endpoint, r)
+		if err != nil {
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				log.Printf("[DEBUG] volume snapshot id %s on project %s deleted", pcvsId, projectId)
+				return r, "deleted", nil
+			}
+			return r, "", err
+		}
+
+		log.Printf("[DEBUG] Pending Volume Snapshot: %s", r)
+		return r, r.Status, nil
+	}
+}
+
+// pcvsPrune deletes the snapshots of the same volume named like this one beyond keep_last.
+func pcvsPrune(d *schema.ResourceData, config *Config) error {
+	keep := d.Get("keep_last").(int)
+	if keep <= 0 {
+		return nil
+	}
+
+	projectId := d.Get("project_id").(string)
+
+	r := &pcvsResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s", projectId, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Will keep the last %d snapshots of volume %s named %q in region %s", keep, r.VolumeId, r.Name, r.Region)
+
+	return pcsPrune(config.OVHClient, projectId, r.Region, r.VolumeId, r.Name, keep)
+}
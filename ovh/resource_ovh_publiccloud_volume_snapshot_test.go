@@ -0,0 +1,75 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudVolumeSnapshotConfig = fmt.Sprintf(`
+resource "ovh_publiccloud_volume" "volume" {
+  project_id = "%s"
+  region     = "GRA1"
+  name       = "terraform_testacc_volume"
+  size       = 10
+}
+
+resource "ovh_publiccloud_volume_snapshot" "snapshot" {
+  project_id  = "${ovh_publiccloud_volume.volume.project_id}"
+  volume_id   = "${ovh_publiccloud_volume.volume.id}"
+  name        = "terraform_testacc_snapshot"
+  description = "acceptance test snapshot"
+}
+`, os.Getenv("OVH_PUBLIC_CLOUD"))
+
+func TestAccPublicCloudVolumeSnapshot_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudVolumePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudVolumeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccPublicCloudVolumeSnapshotConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudVolumeSnapshotExists("ovh_publiccloud_volume_snapshot.snapshot", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_volume_snapshot.snapshot", "status", "available"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_volume_snapshot.snapshot", "region", "GRA1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudVolumeSnapshotExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return pcvsExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckPublicCloudVolumeSnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_publiccloud_volume_snapshot" {
+			continue
+		}
+
+		err := pcvsExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Public Cloud Volume Snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+	return testAccCheckPublicCloudVolumeDestroy(s)
+}
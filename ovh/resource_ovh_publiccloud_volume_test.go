@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudVolumeConfig = `
+resource "ovh_publiccloud_volume" "volume" {
+  project_id = "%s"
+  region     = "GRA1"
+  name       = "terraform_testacc_volume"
+  size       = %d
+}
+`
+
+func TestAccPublicCloudVolume_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudVolumePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudVolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudVolumeConfig, os.Getenv("OVH_PUBLIC_CLOUD"), 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudVolumeExists("ovh_publiccloud_volume.volume", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_volume.volume", "status", "available"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_volume.volume", "type", "classic"),
+					resource.TestCheckResourceAttr("ovh_publiccloud_volume.volume", "size", "10"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudVolumeConfig, os.Getenv("OVH_PUBLIC_CLOUD"), 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudVolumeExists("ovh_publiccloud_volume.volume", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_volume.volume", "size", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPublicCloudVolumePreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckPublicCloudExists(t)
+}
+
+func testAccCheckPublicCloudVolumeExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		if rs.Primary.Attributes["project_id"] == "" {
+			return fmt.Errorf("No Project ID is set")
+		}
+
+		return pcvExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckPublicCloudVolumeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_publiccloud_volume" {
+			continue
+		}
+
+		err := pcvExists(rs.Primary.Attributes["project_id"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Public Cloud Volume %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
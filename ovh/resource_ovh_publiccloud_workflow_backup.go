@@ -0,0 +1,228 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+)
+
+var pcwbID = regexp.MustCompile("^([^/]+)/([^/]+)/([^/]+)$")
+
+func resourcePublicCloudWorkflowBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCloudWorkflowBackupCreate,
+		Read:   resourcePublicCloudWorkflowBackupRead,
+		Update: resourcePublicCloudWorkflowBackupUpdate,
+		Delete: resourcePublicCloudWorkflowBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := pcwbID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract project id, region nor workflow id from id %q, expected projectId/region/workflowId", d.Id())
+				}
+
+				d.Set("project_id", params[1])
+				d.Set("region", params[2])
+				d.SetId(params[3])
+
+				if err := resourcePublicCloudWorkflowBackupRead(d, meta); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_PROJECT_ID", ""),
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cron": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCron,
+			},
+			"rotation": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Params
+type pcwbCreateParams struct {
+	InstanceId string `json:"instanceId"`
+	Name       string `json:"name"`
+	Cron       string `json:"cron"`
+	Rotation   int    `json:"rotation"`
+}
+
+func (p *pcwbCreateParams) String() string {
+	return fmt.Sprintf("instanceId: %s, name: %s, cron: %s, rotation: %d", p.InstanceId, p.Name, p.Cron, p.Rotation)
+}
+
+// Params
+type pcwbUpdateParams struct {
+	Cron string `json:"cron"`
+}
+
+type pcwbResponse struct {
+	Id         string `json:"id"`
+	InstanceId string `json:"instanceId"`
+	Name       string `json:"name"`
+	Cron       string `json:"cron"`
+	Rotation   int    `json:"rotation"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+func (p *pcwbResponse) String() string {
+	return fmt.Sprintf("Id: %s, InstanceId: %s, Name: %s, Cron: %s, Rotation: %d", p.Id, p.InstanceId, p.Name, p.Cron, p.Rotation)
+}
+
+func resourcePublicCloudWorkflowBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+	params := &pcwbCreateParams{
+		InstanceId: d.Get("instance_id").(string),
+		Name:       d.Get("name").(string),
+		Cron:       d.Get("cron").(string),
+		Rotation:   d.Get("rotation").(int),
+	}
+
+	r := &pcwbResponse{}
+
+	log.Printf("[DEBUG] Will create public cloud backup workflow: %s", params)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/workflow/backup", projectId, region)
+
+	err := config.OVHClient.Post(endpoint, params, r)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Created Backup Workflow %s", r)
+
+	//set id
+	d.SetId(r.Id)
+
+	return resourcePublicCloudWorkflowBackupRead(d, meta)
+}
+
+func resourcePublicCloudWorkflowBackupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	r := &pcwbResponse{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/workflow/backup/%s", projectId, region, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] Backup Workflow %s not found in project %s, removing from state", d.Id(), projectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("instance_id", r.InstanceId)
+	d.Set("name", r.Name)
+	d.Set("cron", r.Cron)
+	d.Set("rotation", r.Rotation)
+	d.Set("created_at", r.CreatedAt)
+
+	log.Printf("[DEBUG] Read Public Cloud Backup Workflow %s", r)
+	return nil
+}
+
+func resourcePublicCloudWorkflowBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+	params := &pcwbUpdateParams{
+		Cron: d.Get("cron").(string),
+	}
+
+	log.Printf("[DEBUG] Will update public cloud backup workflow %s cron: %s", d.Id(), params.Cron)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/workflow/backup/%s", projectId, region, d.Id())
+
+	err := config.OVHClient.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %v:\n\t %q", endpoint, params, err)
+	}
+
+	return resourcePublicCloudWorkflowBackupRead(d, meta)
+}
+
+func resourcePublicCloudWorkflowBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	projectId := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	log.Printf("[DEBUG] Will delete public cloud backup workflow for project: %s, id: %s", projectId, d.Id())
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/workflow/backup/%s", projectId, region, d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Deleted Public Cloud Backup Workflow %s from project %s", d.Id(), projectId)
+
+	d.SetId("")
+
+	return nil
+}
+
+func pcwbExists(projectId, region, id string, c *ovh.Client) error {
+	r := &pcwbResponse{}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/workflow/backup/%s", projectId, region, id)
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read backup workflow: %s", r)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccPublicCloudWorkflowBackupConfig = `
+resource "ovh_publiccloud_instance" "instance" {
+  project_id  = "%s"
+  name        = "terraform_testacc_instance"
+  region      = "GRA1"
+  flavor_name = "s1-2"
+  image_name  = "Ubuntu 16.04"
+}
+
+resource "ovh_publiccloud_workflow_backup" "backup" {
+  project_id  = "${ovh_publiccloud_instance.instance.project_id}"
+  region      = "${ovh_publiccloud_instance.instance.region}"
+  instance_id = "${ovh_publiccloud_instance.instance.id}"
+  name        = "terraform_testacc_backup"
+  cron        = "%s"
+  rotation    = 7
+}
+`
+
+func TestAccPublicCloudWorkflowBackup_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckPublicCloudInstancePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPublicCloudWorkflowBackupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudWorkflowBackupConfig, os.Getenv("OVH_PUBLIC_CLOUD"), "0 3 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudWorkflowBackupExists("ovh_publiccloud_workflow_backup.backup", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_workflow_backup.backup", "cron", "0 3 * * *"),
+				),
+			},
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccPublicCloudWorkflowBackupConfig, os.Getenv("OVH_PUBLIC_CLOUD"), "30 4 * * 1-5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicCloudWorkflowBackupExists("ovh_publiccloud_workflow_backup.backup", t),
+					resource.TestCheckResourceAttr("ovh_publiccloud_workflow_backup.backup", "cron", "30 4 * * 1-5"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_publiccloud_workflow_backup.backup",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPublicCloudWorkflowBackupImportId("ovh_publiccloud_workflow_backup.backup"),
+			},
+		},
+	})
+}
+
+func testAccPublicCloudWorkflowBackupImportId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["region"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckPublicCloudWorkflowBackupExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return pcwbExists(rs.Primary.Attributes["project_id"], rs.Primary.Attributes["region"], rs.Primary.ID, config.OVHClient)
+	}
+}
+
+func testAccCheckPublicCloudWorkflowBackupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_publiccloud_workflow_backup" {
+			continue
+		}
+
+		err := pcwbExists(rs.Primary.Attributes["project_id"], rs.Primary.Attributes["region"], rs.Primary.ID, config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("Public Cloud Backup Workflow %s still exists", rs.Primary.ID)
+		}
+	}
+	return testAccCheckPublicCloudInstanceDestroy(s)
+}
@@ -0,0 +1,173 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+)
+
+func resourceVRack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVRackCreate,
+		Read:   resourceVRackRead,
+		Update: resourceVRackUpdate,
+		Delete: resourceVRackDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("vrack_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vrack_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Params
+type vrackUpdateParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (p *vrackUpdateParams) String() string {
+	return fmt.Sprintf("name: %s, description: %s", p.Name, p.Description)
+}
+
+type vrackServiceInfosResponse struct {
+	Status     string `json:"status"`
+	Expiration string `json:"expiration"`
+}
+
+// The vrack service can't be ordered nor terminated through this resource:
+// Create adopts an existing vrack and Delete only releases it from the state.
+func resourceVRackCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+
+	log.Printf("[DEBUG] Will adopt VRack %s", vrackId)
+
+	r, err := vrackGet(config.OVHClient, vrackId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(vrackId)
+
+	// fields left out of the config keep their current value
+	if _, ok := d.GetOk("name"); !ok {
+		d.Set("name", r.Name)
+	}
+	if _, ok := d.GetOk("description"); !ok {
+		d.Set("description", r.Description)
+	}
+
+	if err := resourceVRackUpdateMetadata(d, config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceVRackRead(d, meta)
+}
+
+func resourceVRackRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Id()
+	r := &vrackResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s", vrackId)
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] VRack %s not found, removing from state", vrackId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	infos := &vrackServiceInfosResponse{}
+	endpoint = fmt.Sprintf("/vrack/%s/serviceInfos", vrackId)
+
+	err = config.OVHClient.Get(endpoint, infos)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("vrack_id", vrackId)
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+	d.Set("status", infos.Status)
+	d.Set("expiration", infos.Expiration)
+
+	log.Printf("[DEBUG] Read VRack %s: %s", vrackId, r)
+
+	return nil
+}
+
+func resourceVRackUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		if err := resourceVRackUpdateMetadata(d, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceVRackRead(d, meta)
+}
+
+func resourceVRackDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] VRack %s is only removed from the state, the service itself is left untouched", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func resourceVRackUpdateMetadata(d *schema.ResourceData, c *ovh.Client) error {
+	params := &vrackUpdateParams{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if !d.HasChange("name") && !d.HasChange("description") {
+		return nil
+	}
+
+	endpoint := fmt.Sprintf("/vrack/%s", d.Id())
+
+	log.Printf("[DEBUG] Will update VRack %s: %s", d.Id(), params)
+
+	err := c.Put(endpoint, params, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+	"time"
+)
+
+var vdsaID = regexp.MustCompile("vrack_(.+)-dedicatedserver_(.+)-attach")
+
+func resourceVRackDedicatedServerAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVRackDedicatedServerAttachmentCreate,
+		Read:   resourceVRackDedicatedServerAttachmentRead,
+		Delete: resourceVRackDedicatedServerAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := vdsaID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract vrack id nor server name from id %q", d.Id())
+				}
+
+				d.Set("vrack_id", params[1])
+				d.Set("server_name", params[2])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vrack_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", ""),
+			},
+			"server_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// Params
+type dedicatedServerAttachParams struct {
+	DedicatedServer string `json:"dedicatedServer"`
+}
+
+func resourceVRackDedicatedServerAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	params := &dedicatedServerAttachParams{DedicatedServer: d.Get("server_name").(string)}
+	r := attachTaskResponse{}
+
+	log.Printf("[DEBUG] Will Attach VRack %s -> DedicatedServer %s", vrackId, params.DedicatedServer)
+
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServer", vrackId)
+
+	err := config.OVHClient.Post(endpoint, params, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+	log.Printf("[DEBUG] Waiting for Attachement Task id %d: VRack %s ->  DedicatedServer %s", r.Id, vrackId, params.DedicatedServer)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"completed"},
+		Refresh:    VRackTaskRefreshFunc(config.OVHClient, vrackId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to attach to dedicated server (%s): %s", vrackId, params.DedicatedServer, err)
+	}
+	log.Printf("[DEBUG] Created Attachement Task id %d: VRack %s ->  DedicatedServer %s", r.Id, vrackId, params.DedicatedServer)
+
+	//set id
+	d.SetId(fmt.Sprintf("vrack_%s-dedicatedserver_%s-attach", vrackId, params.DedicatedServer))
+
+	return nil
+}
+
+func resourceVRackDedicatedServerAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	serverName := d.Get("server_name").(string)
+	r := dedicatedServerAttachResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServer/%s", vrackId, serverName)
+
+	err := config.OVHClient.Get(endpoint, &r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] VRack %s is not attached to DedicatedServer %s, removing from state", vrackId, serverName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("vrack_id", r.VRack)
+	d.Set("server_name", r.DedicatedServer)
+
+	log.Printf("[DEBUG] Read VRack %s ->  DedicatedServer %s", vrackId, serverName)
+
+	return nil
+}
+
+func resourceVRackDedicatedServerAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	serverName := d.Get("server_name").(string)
+
+	r := attachTaskResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServer/%s", vrackId, serverName)
+
+	err := config.OVHClient.Delete(endpoint, &r)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachment Deletion Task id %d: VRack %s ->  DedicatedServer %s", r.Id, vrackId, serverName)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"completed"},
+		Refresh:    VRackTaskRefreshFunc(config.OVHClient, vrackId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to detach from dedicated server (%s): %s", vrackId, serverName, err)
+	}
+	log.Printf("[DEBUG] Removed Attachement id %d: VRack %s ->  DedicatedServer %s", r.Id, vrackId, serverName)
+
+	d.SetId("")
+	return nil
+}
+
+type dedicatedServerAttachResponse struct {
+	VRack           string `json:"vrack"`
+	DedicatedServer string `json:"dedicatedServer"`
+}
+
+func vrackDedicatedServerAttachmentExists(vrackId, serverName string, c *ovh.Client) error {
+	r := dedicatedServerAttachResponse{}
+
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServer/%s", vrackId, serverName)
+
+	err := c.Get(endpoint, &r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read Attachment %s -> VRack:%s, Dedicated Server: %s", endpoint, r.VRack, r.DedicatedServer)
+
+	return nil
+}
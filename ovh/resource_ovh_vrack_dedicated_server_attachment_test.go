@@ -0,0 +1,83 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccVRackDedicatedServerAttachmentConfig = fmt.Sprintf(`
+resource "ovh_vrack_dedicated_server_attachment" "attach" {
+  vrack_id    = "%s"
+  server_name = "%s"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_DEDICATED_SERVER"))
+
+func TestAccVRackDedicatedServerAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckVRackDedicatedServerAttachmentPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVRackDedicatedServerAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVRackDedicatedServerAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackDedicatedServerAttachmentExists("ovh_vrack_dedicated_server_attachment.attach", t),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_vrack_dedicated_server_attachment.attach",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVRackDedicatedServerAttachmentPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckVRackExists(t)
+
+	if os.Getenv("OVH_DEDICATED_SERVER") == "" {
+		t.Fatal("OVH_DEDICATED_SERVER must be set for acceptance tests")
+	}
+}
+
+func testAccCheckVRackDedicatedServerAttachmentExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["vrack_id"] == "" {
+			return fmt.Errorf("No VRack ID is set")
+		}
+
+		if rs.Primary.Attributes["server_name"] == "" {
+			return fmt.Errorf("No Server Name is set")
+		}
+
+		return vrackDedicatedServerAttachmentExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["server_name"], config.OVHClient)
+	}
+}
+
+func testAccCheckVRackDedicatedServerAttachmentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_vrack_dedicated_server_attachment" {
+			continue
+		}
+
+		err := vrackDedicatedServerAttachmentExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["server_name"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("VRack > Dedicated Server Attachment still exists")
+		}
+
+	}
+	return nil
+}
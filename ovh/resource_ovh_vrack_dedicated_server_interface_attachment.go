@@ -0,0 +1,177 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"regexp"
+	"time"
+)
+
+var vdsiaID = regexp.MustCompile("vrack_(.+)-dedicatedserverinterface_(.+)-attach")
+
+func resourceVRackDedicatedServerInterfaceAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVRackDedicatedServerInterfaceAttachmentCreate,
+		Read:   resourceVRackDedicatedServerInterfaceAttachmentRead,
+		Delete: resourceVRackDedicatedServerInterfaceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := vdsiaID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract vrack id nor interface id from id %q", d.Id())
+				}
+
+				d.Set("vrack_id", params[1])
+				d.Set("interface_id", params[2])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vrack_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", ""),
+			},
+			"interface_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Params
+type dedicatedServerInterfaceAttachParams struct {
+	DedicatedServerInterface string `json:"dedicatedServerInterface"`
+}
+
+func resourceVRackDedicatedServerInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	params := &dedicatedServerInterfaceAttachParams{DedicatedServerInterface: d.Get("interface_id").(string)}
+	r := attachTaskResponse{}
+
+	log.Printf("[DEBUG] Will Attach VRack %s -> DedicatedServerInterface %s", vrackId, params.DedicatedServerInterface)
+
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServerInterface", vrackId)
+
+	err := config.OVHClient.Post(endpoint, params, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+	log.Printf("[DEBUG] Waiting for Attachement Task id %d: VRack %s ->  DedicatedServerInterface %s", r.Id, vrackId, params.DedicatedServerInterface)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"completed"},
+		Refresh:    VRackTaskRefreshFunc(config.OVHClient, vrackId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to attach to dedicated server interface (%s): %s", vrackId, params.DedicatedServerInterface, err)
+	}
+	log.Printf("[DEBUG] Created Attachement Task id %d: VRack %s ->  DedicatedServerInterface %s", r.Id, vrackId, params.DedicatedServerInterface)
+
+	//set id
+	d.SetId(fmt.Sprintf("vrack_%s-dedicatedserverinterface_%s-attach", vrackId, params.DedicatedServerInterface))
+
+	return nil
+}
+
+func resourceVRackDedicatedServerInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	interfaceId := d.Get("interface_id").(string)
+	r := dedicatedServerInterfaceAttachResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServerInterface/%s", vrackId, interfaceId)
+
+	err := config.OVHClient.Get(endpoint, &r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] VRack %s is not attached to DedicatedServerInterface %s, removing from state", vrackId, interfaceId)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("vrack_id", r.VRack)
+	d.Set("interface_id", r.DedicatedServerInterface)
+	d.Set("server_name", r.DedicatedServer)
+
+	log.Printf("[DEBUG] Read VRack %s ->  DedicatedServerInterface %s", vrackId, interfaceId)
+
+	return nil
+}
+
+func resourceVRackDedicatedServerInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	interfaceId := d.Get("interface_id").(string)
+
+	r := attachTaskResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServerInterface/%s", vrackId, interfaceId)
+
+	err := config.OVHClient.Delete(endpoint, &r)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachment Deletion Task id %d: VRack %s ->  DedicatedServerInterface %s", r.Id, vrackId, interfaceId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"completed"},
+		Refresh:    VRackTaskRefreshFunc(config.OVHClient, vrackId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to detach from dedicated server interface (%s): %s", vrackId, interfaceId, err)
+	}
+	log.Printf("[DEBUG] Removed Attachement id %d: VRack %s ->  DedicatedServerInterface %s", r.Id, vrackId, interfaceId)
+
+	d.SetId("")
+	return nil
+}
+
+type dedicatedServerInterfaceAttachResponse struct {
+	VRack                    string `json:"vrack"`
+	DedicatedServer          string `json:"dedicatedServer"`
+	DedicatedServerInterface string `json:"dedicatedServerInterface"`
+}
+
+func vrackDedicatedServerInterfaceAttachmentExists(vrackId, interfaceId string, c *ovh.Client) error {
+	r := dedicatedServerInterfaceAttachResponse{}
+
+	endpoint := fmt.Sprintf("/vrack/%s/dedicatedServerInterface/%s", vrackId, interfaceId)
+
+	err := c.Get(endpoint, &r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read Attachment %s -> VRack:%s, Dedicated Server Interface: %s", endpoint, r.VRack, r.DedicatedServerInterface)
+
+	return nil
+}
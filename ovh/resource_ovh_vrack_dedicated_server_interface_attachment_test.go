@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccVRackDedicatedServerInterfaceAttachmentConfig = fmt.Sprintf(`
+resource "ovh_vrack_dedicated_server_interface_attachment" "attach" {
+  vrack_id     = "%s"
+  interface_id = "%s"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_DEDICATED_SERVER_INTERFACE"))
+
+func TestAccVRackDedicatedServerInterfaceAttachment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckVRackDedicatedServerInterfaceAttachmentPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVRackDedicatedServerInterfaceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVRackDedicatedServerInterfaceAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackDedicatedServerInterfaceAttachmentExists("ovh_vrack_dedicated_server_interface_attachment.attach", t),
+					resource.TestCheckResourceAttrSet("ovh_vrack_dedicated_server_interface_attachment.attach", "server_name"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_vrack_dedicated_server_interface_attachment.attach",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVRackDedicatedServerInterfaceAttachmentPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckVRackExists(t)
+
+	if os.Getenv("OVH_DEDICATED_SERVER_INTERFACE") == "" {
+		t.Fatal("OVH_DEDICATED_SERVER_INTERFACE must be set for acceptance tests")
+	}
+}
+
+func testAccCheckVRackDedicatedServerInterfaceAttachmentExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["vrack_id"] == "" {
+			return fmt.Errorf("No VRack ID is set")
+		}
+
+		if rs.Primary.Attributes["interface_id"] == "" {
+			return fmt.Errorf("No Interface ID is set")
+		}
+
+		return vrackDedicatedServerInterfaceAttachmentExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["interface_id"], config.OVHClient)
+	}
+}
+
+func testAccCheckVRackDedicatedServerInterfaceAttachmentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_vrack_dedicated_server_interface_attachment" {
+			continue
+		}
+
+		err := vrackDedicatedServerInterfaceAttachmentExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["interface_id"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("VRack > Dedicated Server Interface Attachment still exists")
+		}
+
+	}
+	return nil
+}
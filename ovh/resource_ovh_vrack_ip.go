@@ -0,0 +1,189 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"log"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+var vipID = regexp.MustCompile("vrack_(.+)-ip_(.+)-attach")
+
+func resourceVRackIP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVRackIPCreate,
+		Read:   resourceVRackIPRead,
+		Delete: resourceVRackIPDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				params := vipID.FindStringSubmatch(d.Id())
+				if params == nil {
+					return nil, fmt.Errorf("[ERROR] couln't extract vrack id nor ip block from id %q", d.Id())
+				}
+
+				d.Set("vrack_id", params[1])
+				d.Set("block", params[2])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vrack_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_VRACK_ID", ""),
+			},
+			"block": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDR,
+			},
+			"gateway": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Params
+type vrackIPParams struct {
+	Block string `json:"block"`
+}
+
+type vrackIPResponse struct {
+	VRack   string `json:"vrack"`
+	Ip      string `json:"ip"`
+	Gateway string `json:"gateway"`
+	Zone    string `json:"zone"`
+}
+
+func (p *vrackIPResponse) String() string {
+	return fmt.Sprintf("VRackIP[VRack: %s, Ip: %s, Gateway: %s, Zone: %s]", p.VRack, p.Ip, p.Gateway, p.Zone)
+}
+
+func resourceVRackIPCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	params := &vrackIPParams{Block: d.Get("block").(string)}
+	r := attachTaskResponse{}
+
+	log.Printf("[DEBUG] Will Attach VRack %s -> IP %s", vrackId, params.Block)
+
+	endpoint := fmt.Sprintf("/vrack/%s/ip", vrackId)
+
+	err := config.OVHClient.Post(endpoint, params, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s with params %s:\n\t %q", endpoint, params, err)
+	}
+	log.Printf("[DEBUG] Waiting for Attachement Task id %d: VRack %s ->  IP %s", r.Id, vrackId, params.Block)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"completed"},
+		Refresh:    VRackTaskRefreshFunc(config.OVHClient, vrackId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to attach ip block (%s): %s", vrackId, params.Block, err)
+	}
+	log.Printf("[DEBUG] Created Attachement Task id %d: VRack %s ->  IP %s", r.Id, vrackId, params.Block)
+
+	//set id
+	d.SetId(fmt.Sprintf("vrack_%s-ip_%s-attach", vrackId, params.Block))
+
+	return resourceVRackIPRead(d, meta)
+}
+
+func resourceVRackIPRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	block := d.Get("block").(string)
+	r := &vrackIPResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/ip/%s", vrackId, url.PathEscape(block))
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			log.Printf("[WARN] IP %s is not attached to VRack %s, removing from state", block, vrackId)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("vrack_id", r.VRack)
+	d.Set("block", r.Ip)
+	d.Set("gateway", r.Gateway)
+	d.Set("zone", r.Zone)
+
+	log.Printf("[DEBUG] Read VRack IP %s", r)
+
+	return nil
+}
+
+func resourceVRackIPDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	vrackId := d.Get("vrack_id").(string)
+	block := d.Get("block").(string)
+
+	r := attachTaskResponse{}
+	endpoint := fmt.Sprintf("/vrack/%s/ip/%s", vrackId, url.PathEscape(block))
+
+	err := config.OVHClient.Delete(endpoint, &r)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Waiting for Attachment Deletion Task id %d: VRack %s ->  IP %s", r.Id, vrackId, block)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"completed"},
+		Refresh:    VRackTaskRefreshFunc(config.OVHClient, vrackId, r.Id),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to detach ip block (%s): %s", vrackId, block, err)
+	}
+	log.Printf("[DEBUG] Removed Attachement id %d: VRack %s ->  IP %s", r.Id, vrackId, block)
+
+	d.SetId("")
+	return nil
+}
+
+func vrackIPExists(vrackId, block string, c *ovh.Client) error {
+	r := &vrackIPResponse{}
+
+	endpoint := fmt.Sprintf("/vrack/%s/ip/%s", vrackId, url.PathEscape(block))
+
+	err := c.Get(endpoint, r)
+	if err != nil {
+		return fmt.Errorf("Error while querying %s: %q\n", endpoint, err)
+	}
+	log.Printf("[DEBUG] Read Attachment %s -> %s", endpoint, r)
+
+	return nil
+}
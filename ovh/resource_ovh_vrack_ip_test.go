@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccVRackIPConfig = fmt.Sprintf(`
+resource "ovh_vrack_ip" "ip" {
+  vrack_id = "%s"
+  block    = "%s"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_IP_BLOCK"))
+
+func TestAccVRackIP_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckVRackIPPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVRackIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVRackIPConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVRackIPExists("ovh_vrack_ip.ip", t),
+					resource.TestCheckResourceAttrSet("ovh_vrack_ip.ip", "gateway"),
+					resource.TestCheckResourceAttrSet("ovh_vrack_ip.ip", "zone"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_vrack_ip.ip",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVRackIPPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckVRackExists(t)
+
+	if os.Getenv("OVH_IP_BLOCK") == "" {
+		t.Fatal("OVH_IP_BLOCK must be set for acceptance tests")
+	}
+}
+
+func testAccCheckVRackIPExists(n string, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["vrack_id"] == "" {
+			return fmt.Errorf("No VRack ID is set")
+		}
+
+		if rs.Primary.Attributes["block"] == "" {
+			return fmt.Errorf("No IP block is set")
+		}
+
+		return vrackIPExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["block"], config.OVHClient)
+	}
+}
+
+func testAccCheckVRackIPDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_vrack_ip" {
+			continue
+		}
+
+		err := vrackIPExists(rs.Primary.Attributes["vrack_id"], rs.Primary.Attributes["block"], config.OVHClient)
+		if err == nil {
+			return fmt.Errorf("VRack > IP still exists")
+		}
+
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ovh/go-ovh/ovh"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 )
 
@@ -59,6 +60,11 @@ type taskParams struct {
 	TaskId      string `json:"taskId"`
 }
 
+type publicCloudAttachResponse struct {
+	VRack   string `json:"vrack"`
+	Project string `json:"project"`
+}
+
 type attachTaskResponse struct {
 	Id           int       `json:"id"`
 	Function     string    `json:"function"`
@@ -105,7 +111,7 @@ func resourceVRackPublicCloudAttachmentCreate(d *schema.ResourceData, meta inter
 	//set id
 	d.SetId(fmt.Sprintf("vrack_%s-cloudproject_%s-attach", vrackId, params.Project))
 
-	return nil
+	return resourceVRackPublicCloudAttachmentRead(d, meta)
 }
 
 func resourceVRackPublicCloudAttachmentRead(d *schema.ResourceData, meta interface{}) error {
@@ -113,18 +119,77 @@ func resourceVRackPublicCloudAttachmentRead(d *schema.ResourceData, meta interfa
 
 	vrackId := d.Get("vrack_id").(string)
 	params := &attachParams{Project: d.Get("project_id").(string)}
-	r := attachTaskResponse{}
+
+	r := publicCloudAttachResponse{}
 	endpoint := fmt.Sprintf("/vrack/%s/cloudProject/%s", vrackId, params.Project)
 
 	err := config.OVHClient.Get(endpoint, &r)
 	if err != nil {
-		return err
+		if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+			// the project only shows as attached once the attach task is done
+			task, err := vrackPublicCloudPendingTask(config.OVHClient, vrackId, params.Project)
+			if err != nil {
+				return err
+			}
+			if task != nil && !strings.HasPrefix(task.Function, "remove") {
+				log.Printf("[WARN] PublicCloud %s is being attached to VRack %s by Task id %d (%s, status %s), keeping it in state", params.Project, vrackId, task.Id, task.Function, task.Status)
+				return nil
+			}
+
+			log.Printf("[WARN] PublicCloud %s is not attached to VRack %s anymore, removing from state", params.Project, vrackId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
 	}
-	log.Printf("[DEBUG] Read VRack %s ->  PublicCloud %s", vrackId, params.Project)
+
+	d.Set("vrack_id", r.VRack)
+	d.Set("project_id", r.Project)
+
+	log.Printf("[DEBUG] Read VRack %s ->  PublicCloud %s", r.VRack, r.Project)
 
 	return nil
 }
 
+// vrackPublicCloudPendingTask returns the first unfinished vrack task
+// targeting the given public cloud project, or nil if there is none.
+// Only unfinished tasks are listed by the API.
+func vrackPublicCloudPendingTask(c *ovh.Client, vrackId, projectId string) (*attachTaskResponse, error) {
+	tasks := []int{}
+	endpoint := fmt.Sprintf("/vrack/%s/task", vrackId)
+
+	err := c.Get(endpoint, &tasks)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+	}
+
+	for _, taskId := range tasks {
+		task := &attachTaskResponse{}
+		endpoint := fmt.Sprintf("/vrack/%s/task/%d", vrackId, taskId)
+
+		err := c.Get(endpoint, task)
+		if err != nil {
+			// the task may have completed in between
+			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
+				continue
+			}
+			return nil, fmt.Errorf("[ERROR] calling %s:\n\t %q", endpoint, err)
+		}
+
+		if task.TargetDomain != projectId {
+			continue
+		}
+
+		switch task.Status {
+		case "init", "todo", "doing":
+			log.Printf("[DEBUG] Found pending Task id %d on VRack %s: %s %s", task.Id, vrackId, task.Function, task.TargetDomain)
+			return task, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func resourceVRackPublicCloudAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -161,12 +226,7 @@ func resourceVRackPublicCloudAttachmentDelete(d *schema.ResourceData, meta inter
 }
 
 func vrackPublicCloudAttachmentExists(vrackId, projectId string, c *ovh.Client) error {
-	type attachResponse struct {
-		VRack   string `json:"vrack"`
-		Project string `json:"project"`
-	}
-
-	r := attachResponse{}
+	r := publicCloudAttachResponse{}
 
 	endpoint := fmt.Sprintf("/vrack/%s/cloudProject/%s", vrackId, projectId)
 
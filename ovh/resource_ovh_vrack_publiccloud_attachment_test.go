@@ -27,6 +27,11 @@ func TestAccVRackPublicCloudAttachment_basic(t *testing.T) {
 					testAccCheckVRackPublicCloudAttachmentExists("ovh_vrack_publiccloud_attachment.attach", t),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "ovh_vrack_publiccloud_attachment.attach",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,63 @@
+package ovh
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"os"
+	"testing"
+)
+
+var testAccVRackConfig = fmt.Sprintf(`
+resource "ovh_vrack" "vrack" {
+  vrack_id    = "%s"
+  name        = "terraform_testacc_vrack"
+  description = "terraform acceptance test"
+}
+`, os.Getenv("OVH_VRACK"))
+
+func TestAccVRack_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckVRackPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVRackDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVRackConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_vrack.vrack", "name", "terraform_testacc_vrack"),
+					resource.TestCheckResourceAttr("ovh_vrack.vrack", "description", "terraform acceptance test"),
+					resource.TestCheckResourceAttrSet("ovh_vrack.vrack", "status"),
+					resource.TestCheckResourceAttrSet("ovh_vrack.vrack", "expiration"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ovh_vrack.vrack",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVRackPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccCheckVRackExists(t)
+}
+
+// Destroying the resource only releases it from the state:
+// the vrack service must still be there.
+func testAccCheckVRackDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_vrack" {
+			continue
+		}
+
+		_, err := vrackGet(config.OVHClient, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("VRack %s has been deleted: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
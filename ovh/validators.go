@@ -0,0 +1,210 @@
+package ovh
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+var cronField = regexp.MustCompile(`^[0-9*,/-]+$`)
+
+func validateIP(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if net.ParseIP(value) == nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid IP address, got: %q", k, value))
+	}
+	return
+}
+
+func validateCIDR(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	ip, ipnet, err := net.ParseCIDR(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid CIDR, got: %q", k, value))
+		return
+	}
+	if !ip.Equal(ipnet.IP) {
+		errors = append(errors, fmt.Errorf("%q must be a network address, got: %q (did you mean %q?)", k, value, ipnet.String()))
+	}
+	return
+}
+
+func validateIPVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value != 4 && value != 6 {
+		errors = append(errors, fmt.Errorf("%q must be 4 or 6, got: %d", k, value))
+	}
+	return
+}
+
+// ipVersion returns the version of an IP address or a CIDR, or 0 if
+// the value can't be parsed.
+func ipVersion(value string) int {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		var err error
+		ip, _, err = net.ParseCIDR(value)
+		if err != nil {
+			return 0
+		}
+	}
+
+	if ip.To4() != nil {
+		return 4
+	}
+	return 6
+}
+
+// validateS3Policy checks that the value is a JSON policy document
+// with a Statement list.
+func validateS3Policy(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid JSON object: %s", k, err))
+		return
+	}
+
+	if _, ok := policy["Statement"].([]interface{}); !ok {
+		errors = append(errors, fmt.Errorf("%q must contain a Statement list", k))
+	}
+	return
+}
+
+// validateCron checks that the value is a 5 fields cron expression
+// made of numbers, wildcards, lists, ranges and steps.
+func validateCron(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	fields := strings.Fields(value)
+	if len(fields) != 5 {
+		errors = append(errors, fmt.Errorf("%q must be a 5 fields cron expression, got: %q", k, value))
+		return
+	}
+
+	for _, f := range fields {
+		if !cronField.MatchString(f) {
+			errors = append(errors, fmt.Errorf("%q has an invalid cron field %q in: %q", k, f, value))
+		}
+	}
+	return
+}
+
+var sshKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-dss",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+}
+
+// validateSSHPublicKey checks that the value is an OpenSSH public key:
+// a known key type followed by the base64 encoded key blob, which must
+// itself start with the same key type, and an optional comment.
+func validateSSHPublicKey(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	fields := strings.Fields(value)
+	if len(fields) < 2 {
+		errors = append(errors, fmt.Errorf("%q must be an OpenSSH public key such as \"ssh-rsa AAAA... comment\"", k))
+		return
+	}
+
+	keyType := fields[0]
+	known := false
+	for _, t := range sshKeyTypes {
+		if t == keyType {
+			known = true
+		}
+	}
+	if !known {
+		errors = append(errors, fmt.Errorf("%q has an unsupported key type %q, expected one of %v", k, keyType, sshKeyTypes))
+		return
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q key data is not valid base64: %s", k, err))
+		return
+	}
+
+	if len(blob) < 4 {
+		errors = append(errors, fmt.Errorf("%q key data is truncated", k))
+		return
+	}
+
+	n := binary.BigEndian.Uint32(blob[:4])
+	if uint64(n) > uint64(len(blob)-4) || string(blob[4:4+n]) != keyType {
+		errors = append(errors, fmt.Errorf("%q key data doesn't match key type %q", k, keyType))
+	}
+	return
+}
+
+// validateIPRange checks that start and end are addresses of the network cidr
+// and that start is lower or equal than end.
+func validateIPRange(cidr, start, end string) error {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid CIDR: %s", cidr, err)
+	}
+
+	startIp := net.ParseIP(start)
+	if startIp == nil {
+		return fmt.Errorf("%q is not a valid IP address", start)
+	}
+	endIp := net.ParseIP(end)
+	if endIp == nil {
+		return fmt.Errorf("%q is not a valid IP address", end)
+	}
+
+	if !ipnet.Contains(startIp) {
+		return fmt.Errorf("start %s is not in network %s", start, cidr)
+	}
+	if !ipnet.Contains(endIp) {
+		return fmt.Errorf("end %s is not in network %s", end, cidr)
+	}
+	if compareIPs(startIp, endIp) > 0 {
+		return fmt.Errorf("start %s must be lower or equal than end %s", start, end)
+	}
+	return nil
+}
+
+// validateGatewayIP checks that the gateway is an address of the network cidr
+// which doesn't belong to the start-end pool.
+func validateGatewayIP(cidr, start, end, gateway string) error {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid CIDR: %s", cidr, err)
+	}
+
+	gatewayIp := net.ParseIP(gateway)
+	if gatewayIp == nil {
+		return fmt.Errorf("%q is not a valid IP address", gateway)
+	}
+	if !ipnet.Contains(gatewayIp) {
+		return fmt.Errorf("gateway %s is not in network %s", gateway, cidr)
+	}
+
+	startIp := net.ParseIP(start)
+	endIp := net.ParseIP(end)
+	if startIp != nil && endIp != nil && compareIPs(startIp, gatewayIp) <= 0 && compareIPs(gatewayIp, endIp) <= 0 {
+		return fmt.Errorf("gateway %s must not be in the pool %s-%s", gateway, start, end)
+	}
+	return nil
+}
+
+// compareIPs compares two IP addresses of the same family as
+// bytes.Compare does.
+func compareIPs(a, b net.IP) int {
+	if a4, b4 := a.To4(), b.To4(); a4 != nil && b4 != nil {
+		return bytes.Compare(a4, b4)
+	}
+	return bytes.Compare(a.To16(), b.To16())
+}